@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 	"github.com/heimweh/go-pagerduty/persistentconfig"
@@ -23,7 +24,9 @@ type Config struct {
 	// Override default PagerDuty API URL
 	ApiUrlOverride string
 
-	// The PagerDuty APP URL
+	// The PagerDuty APP URL of the service region, for the endpoints only the
+	// web app serves, e.g. the Slack integration ones. `html_url` values come
+	// from the API already pointing to the account's domain and are kept as is.
 	AppUrl string
 
 	// The PagerDuty API V2 token
@@ -32,6 +35,9 @@ type Config struct {
 	// The PagerDuty User level token for Slack
 	UserToken string
 
+	// Email of the PagerDuty user sent in the `From` header of the requests
+	UserEmail string
+
 	// Skip validation of the token against the PagerDuty API
 	SkipCredsValidation bool
 
@@ -41,16 +47,46 @@ type Config struct {
 	// Do not verify TLS certs for HTTPS requests - useful if you're behind a corporate proxy
 	InsecureTls bool
 
+	// Hostnames for which TLS certs are not verified on HTTPS requests
+	InsecureTlsHosts []string
+
 	APITokenType *pagerduty.AuthTokenType
 
 	AppOauthScopedTokenParams *persistentconfig.AppOauthScopedTokenParams
 
 	ServiceRegion string
 
+	// Maximum time to keep retrying a request to the PagerDuty API
+	RetryTime time.Duration
+
+	// Maximum time to keep retrying a long-running request to the PagerDuty API
+	RetryTimeLong time.Duration
+
+	// Maximum time a single request to the PagerDuty API can take, retries
+	// aside
+	RequestTimeout time.Duration
+
 	client      *pagerduty.Client
 	slackClient *pagerduty.Client
+
+	// Lookups cached during the lifetime of the provider configuration
+	lookupMu   sync.Mutex
+	teamIDs    map[string]string
+	priorities []*pagerduty.Priority
 }
 
+// Default maximum amount of time resources keep retrying a request to the
+// PagerDuty API before giving up, when not set in the provider configuration.
+const (
+	defaultRetryTime     = 2 * time.Minute
+	defaultRetryTimeLong = 5 * time.Minute
+)
+
+// Default maximum amount of time a single request to the PagerDuty API can
+// take, when not set in the provider configuration. It is kept well below
+// defaultRetryTime so a hanging request still leaves room to retry.
+const defaultRequestTimeout = 1 * time.Minute
+
 const invalidCreds = `
 
 No valid credentials found for PagerDuty provider.
@@ -73,15 +109,15 @@ func (c *Config) Client() (*pagerduty.Client, error) {
 		return nil, fmt.Errorf(invalidCreds)
 	}
 
-	var httpClient *http.Client
-	httpClient = http.DefaultClient
-	httpClient.Timeout = 1 * time.Minute
+	httpClient := &http.Client{Timeout: c.requestTimeout()}
 
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	if c.InsecureTls {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	} else if len(c.InsecureTlsHosts) > 0 {
+		transport.TLSClientConfig = util.InsecureHostsTLSConfig(c.InsecureTlsHosts)
 	}
-	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
+	httpClient.Transport = logging.NewTransport("PagerDuty", &util.FromHeaderTransport{Email: c.UserEmail, Next: transport})
 
 	apiUrl := c.ApiUrl
 	if c.ApiUrlOverride != "" {
@@ -132,12 +168,13 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 		return nil, fmt.Errorf(invalidCreds)
 	}
 
-	var httpClient *http.Client
-	httpClient = http.DefaultClient
+	httpClient := &http.Client{Timeout: c.requestTimeout()}
 
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	if c.InsecureTls {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	} else if len(c.InsecureTlsHosts) > 0 {
+		transport.TLSClientConfig = util.InsecureHostsTLSConfig(c.InsecureTlsHosts)
 	}
 	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
 
@@ -160,3 +197,46 @@ func (c *Config) SlackClient() (*pagerduty.Client, error) {
 
 	return c.slackClient, nil
 }
+
+const missingUserEmail = `
+A PagerDuty user email is required to send in the "From" header of the
+request. Please set the "user_email" argument of the provider or the
+PAGERDUTY_USER_EMAIL environment variable.
+`
+
+// userEmail returns the email of the PagerDuty user set in the provider
+// configuration in `meta`, for requests requiring a `From` header.
+func userEmail(meta interface{}) (string, error) {
+	if c, ok := meta.(*Config); ok && c.UserEmail != "" {
+		return c.UserEmail, nil
+	}
+	return "", fmt.Errorf(missingUserEmail)
+}
+
+// requestTimeout returns the maximum amount of time a single request to the
+// PagerDuty API can take.
+func (c *Config) requestTimeout() time.Duration {
+	if c.RequestTimeout > 0 {
+		return c.RequestTimeout
+	}
+	return defaultRequestTimeout
+}
+
+// retryTime returns the maximum amount of time to keep retrying a request to
+// the PagerDuty API for the provider configuration in `meta`.
+func retryTime(meta interface{}) time.Duration {
+	if c, ok := meta.(*Config); ok && c.RetryTime > 0 {
+		return c.RetryTime
+	}
+	return defaultRetryTime
+}
+
+// retryTimeLong returns the maximum amount of time to keep retrying a
+// long-running request to the PagerDuty API for the provider configuration in
+// `meta`.
+func retryTimeLong(meta interface{}) time.Duration {
+	if c, ok := meta.(*Config); ok && c.RetryTimeLong > 0 {
+		return c.RetryTimeLong
+	}
+	return defaultRetryTimeLong
+}
@@ -1,7 +1,10 @@
 package pagerduty
 
 import (
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // Test config with an empty token
@@ -78,3 +81,144 @@ func TestConfigInsecureTls(t *testing.T) {
 		t.Fatalf("error: expected the client to not fail: %v", err)
 	}
 }
+
+// Test config with InsecureTlsHosts
+func TestConfigInsecureTlsHosts(t *testing.T) {
+	config := Config{
+		Token:               "foo",
+		InsecureTlsHosts:    []string{"proxy.example.internal"},
+		SkipCredsValidation: true,
+	}
+
+	if _, err := config.Client(); err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+}
+
+// Test retry timeouts are taken from each provider configuration
+func TestConfigRetryTimeouts(t *testing.T) {
+	defaults := &Config{}
+	custom := &Config{
+		RetryTime:     30 * time.Second,
+		RetryTimeLong: 90 * time.Second,
+	}
+
+	if got := retryTime(defaults); got != defaultRetryTime {
+		t.Errorf("expected retry time to be %v, got %v", defaultRetryTime, got)
+	}
+	if got := retryTimeLong(defaults); got != defaultRetryTimeLong {
+		t.Errorf("expected long retry time to be %v, got %v", defaultRetryTimeLong, got)
+	}
+	if got := retryTime(custom); got != 30*time.Second {
+		t.Errorf("expected retry time to be %v, got %v", 30*time.Second, got)
+	}
+	if got := retryTimeLong(custom); got != 90*time.Second {
+		t.Errorf("expected long retry time to be %v, got %v", 90*time.Second, got)
+	}
+}
+
+// Test resources stop retrying once the configured retry timeout is reached
+func TestConfigRetryTimeoutIsUsed(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	d := resourcePagerDutyTeam().TestResourceData()
+	d.SetId("PXXXXXX")
+
+	start := time.Now()
+	err := resourcePagerDutyTeamRead(d, config)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatalf("expected the read to fail")
+	}
+	if elapsed > 30*time.Second {
+		t.Errorf("expected the read to give up after the configured retry timeout, took %v", elapsed)
+	}
+	if atomic.LoadInt32(&requests) == 0 {
+		t.Errorf("expected at least one request to the API")
+	}
+}
+
+// Test a hanging request is cut at the request timeout and retried until the
+// retry timeout is reached
+func TestConfigRequestTimeoutIsUsed(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{
+		RetryTime:      4 * time.Second,
+		RequestTimeout: 200 * time.Millisecond,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-r.Context().Done()
+	})
+
+	if got := config.requestTimeout(); got != 200*time.Millisecond {
+		t.Errorf("expected request timeout to be %v, got %v", 200*time.Millisecond, got)
+	}
+	if got := (&Config{}).requestTimeout(); got != defaultRequestTimeout {
+		t.Errorf("expected request timeout to be %v, got %v", defaultRequestTimeout, got)
+	}
+
+	d := resourcePagerDutyTeam().TestResourceData()
+	d.SetId("PXXXXXX")
+
+	start := time.Now()
+	err := resourcePagerDutyTeamRead(d, config)
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatalf("expected the read to fail")
+	}
+	if elapsed > 30*time.Second {
+		t.Errorf("expected the read to give up after the configured retry timeout, took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got < 2 {
+		t.Errorf("expected the timed out request to be retried, got %d requests", got)
+	}
+}
+
+// Test the configured user email is sent in the From header of the requests
+func TestConfigUserEmailFromHeader(t *testing.T) {
+	var from string
+	config := newTestConfig(t, &Config{UserEmail: "foo@example.com"}, func(w http.ResponseWriter, r *http.Request) {
+		from = r.Header.Get("From")
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"maintenance_window": {"id": "PXXXXXX"}}`))
+	})
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+
+	if _, _, err := client.MaintenanceWindows.Get("PXXXXXX"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if from != config.UserEmail {
+		t.Errorf("expected the From header to be %q, got %q", config.UserEmail, from)
+	}
+}
+
+// Test a missing user email is reported when a request requires it
+func TestConfigUserEmailMissing(t *testing.T) {
+	if _, err := userEmail(&Config{Token: "foo"}); err == nil {
+		t.Fatalf("expected an error for a missing user email")
+	}
+
+	d := resourcePagerDutyResponsePlay().TestResourceData()
+	d.Set("name", "foo")
+
+	if _, err := buildResponsePlayStruct(d, &Config{Token: "foo"}); err == nil {
+		t.Errorf("expected an error building a response play without from or user email")
+	}
+
+	rp, err := buildResponsePlayStruct(d, &Config{Token: "foo", UserEmail: "foo@example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rp.FromEmail != "foo@example.com" {
+		t.Errorf("expected the response play from to default to the user email, got %q", rp.FromEmail)
+	}
+}
@@ -106,7 +106,7 @@ func dataSourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Reading PagerDuty AutomationActionsAction")
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		automationActionsAction, _, err := client.AutomationActionsAction.Get(d.Get("id").(string))
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -61,7 +61,7 @@ func dataSourcePagerDutyAutomationActionsRunnerRead(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Reading PagerDuty automation actions runner")
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		runner, _, err := client.AutomationActionsRunner.Get(d.Get("id").(string))
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
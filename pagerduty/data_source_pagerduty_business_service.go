@@ -39,7 +39,7 @@ func dataSourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interfa
 
 	searchName := d.Get("name").(string)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.BusinessServices.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
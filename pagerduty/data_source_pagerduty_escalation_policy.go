@@ -1,12 +1,12 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
@@ -33,46 +33,36 @@ func dataSourcePagerDutyEscalationPolicyRead(d *schema.ResourceData, meta interf
 	log.Printf("[INFO] Reading PagerDuty escalation policy")
 
 	searchName := d.Get("name").(string)
-	var offset int = 0
 	var found *pagerduty.EscalationPolicy
-	more := true
-
-	for more {
-		err := retry.Retry(5*time.Minute, func() *retry.RetryError {
-			o := &pagerduty.ListEscalationPoliciesOptions{
-				Query:  searchName,
-				Limit:  100,
-				Offset: offset,
-			}
-
-			resp, _, err := client.EscalationPolicies.List(o)
-			if err != nil {
-				if isErrCode(err, http.StatusBadRequest) {
-					return retry.NonRetryableError(err)
-				}
-
-				// Delaying retry by 30s as recommended by PagerDuty
-				// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
-				time.Sleep(30 * time.Second)
-				return retry.RetryableError(err)
-			}
+	o := &pagerduty.ListEscalationPoliciesOptions{
+		Query: searchName,
+		Limit: apiutil.Limit,
+	}
 
-			offset += 100
-			more = resp.More
+	// Delaying retry by 30s as recommended by PagerDuty
+	// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+	opts := apiutil.AllOptions{
+		Timeout:        retryTimeLong(meta),
+		Delay:          30 * time.Second,
+		IsNonRetryable: isBadRequestErr,
+	}
+	err = apiutil.AllPages(context.Background(), opts, func(offset int) (int, bool, error) {
+		o.Offset = offset
+		resp, _, err := client.EscalationPolicies.List(o)
+		if err != nil {
+			return 0, false, err
+		}
 
-			for _, policy := range resp.EscalationPolicies {
-				if policy.Name == searchName {
-					found = policy
-					more = false
-					return nil
-				}
+		for _, policy := range resp.EscalationPolicies {
+			if policy.Name == searchName {
+				found = policy
+				return len(resp.EscalationPolicies), false, nil
 			}
-
-			return nil
-		})
-		if err != nil {
-			return err
 		}
+		return len(resp.EscalationPolicies), resp.More, nil
+	})
+	if err != nil {
+		return err
 	}
 
 	if found == nil {
@@ -4,11 +4,26 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func TestDataSourcePagerDutyEscalationPolicyReadSecondPage(t *testing.T) {
+	config := newTestConfig(t, &Config{}, twoPageListHandler("/escalation_policies", "escalation_policies",
+		`{"id": "PFIRST1", "name": "Default Escalation"}`,
+		`{"id": "PSECOND", "name": "Default"}`))
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutyEscalationPolicy().Schema, map[string]interface{}{"name": "Default"})
+
+	if err := dataSourcePagerDutyEscalationPolicyRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Id() != "PSECOND" {
+		t.Errorf("expected the escalation policy on the second page, got %q", d.Id())
+	}
+}
+
 func TestAccDataSourcePagerDutyEscalationPolicy_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -67,7 +66,7 @@ func dataSourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta inte
 
 	searchName := d.Get("name").(string)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.EventOrchestrations.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -42,6 +42,7 @@ func dataSourcePagerDutyEventOrchestrationGlobalCacheVariable() *schema.Resource
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"updated_by": eventOrchestrationCacheVariableUpdatedBySchema,
 		},
 	}
 }
@@ -82,7 +82,7 @@ func getEventOrchestrationIntegrationById(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Integration data source by ID '%s' for PagerDuty Event Orchestration '%s'", id, oid)
 
 		if integration, _, err := client.EventOrchestrationIntegrations.GetContext(ctx, oid, id); err != nil {
@@ -116,7 +116,7 @@ func getEventOrchestrationIntegrationByLabel(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Integration data source by label '%s' for PagerDuty Event Orchestration '%s'", lbl, oid)
 
 		resp, _, err := client.EventOrchestrationIntegrations.ListContext(ctx, oid)
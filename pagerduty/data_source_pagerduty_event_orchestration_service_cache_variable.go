@@ -42,6 +42,7 @@ func dataSourcePagerDutyEventOrchestrationServiceCacheVariable() *schema.Resourc
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"updated_by": eventOrchestrationCacheVariableUpdatedBySchema,
 		},
 	}
 }
@@ -85,7 +85,7 @@ func dataSourcePagerDutyEventOrchestrationsRead(d *schema.ResourceData, meta int
 	nameFilter := d.Get("name_filter").(string)
 
 	var eoList []*pagerduty.EventOrchestration
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := client.EventOrchestrations.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -119,7 +119,7 @@ func dataSourcePagerDutyEventOrchestrationsRead(d *schema.ResourceData, meta int
 	for _, orchestration := range eoList {
 		// Get orchestration matched by ID so we can set the integrations property
 		// since the list endpoint does not return it
-		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 			orch, _, err := client.EventOrchestrations.Get(orchestration.ID)
 			if err != nil {
 				if isErrCode(err, http.StatusBadRequest) {
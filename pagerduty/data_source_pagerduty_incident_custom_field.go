@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,8 +19,14 @@ func dataSourcePagerDutyIncidentCustomField() *schema.Resource {
 		ReadContext: dataSourcePagerDutyIncidentCustomFieldRead,
 		Schema: map[string]*schema.Schema{
 			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"name", "query"},
+			},
+			"query": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
 			},
 			"display_name": {
 				Type:     schema.TypeString,
@@ -50,8 +57,9 @@ func dataSourcePagerDutyIncidentCustomFieldRead(ctx context.Context, d *schema.R
 	log.Printf("[INFO] Reading PagerDuty data source")
 
 	searchName := d.Get("name").(string)
+	searchQuery := d.Get("query").(string)
 
-	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.IncidentCustomFields.ListContext(ctx, nil)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -64,19 +72,9 @@ func dataSourcePagerDutyIncidentCustomFieldRead(ctx context.Context, d *schema.R
 			return retry.RetryableError(err)
 		}
 
-		var found *pagerduty.IncidentCustomField
-
-		for _, field := range resp.Fields {
-			if field.Name == searchName {
-				found = field
-				break
-			}
-		}
-
-		if found == nil {
-			return retry.NonRetryableError(
-				fmt.Errorf("unable to locate any field with name: %s", searchName),
-			)
+		found, err := findIncidentCustomField(resp.Fields, searchName, searchQuery)
+		if err != nil {
+			return retry.NonRetryableError(err)
 		}
 
 		err = flattenIncidentCustomField(d, found)
@@ -92,3 +90,36 @@ func dataSourcePagerDutyIncidentCustomFieldRead(ctx context.Context, d *schema.R
 	}
 	return nil
 }
+
+// findIncidentCustomField returns the field named `name`, or when `query` is
+// set instead, the only field whose name contains it regardless of case.
+func findIncidentCustomField(fields []*pagerduty.IncidentCustomField, name, query string) (*pagerduty.IncidentCustomField, error) {
+	if query == "" {
+		for _, field := range fields {
+			if field.Name == name {
+				return field, nil
+			}
+		}
+		return nil, fmt.Errorf("unable to locate any field with name: %s", name)
+	}
+
+	var candidates []*pagerduty.IncidentCustomField
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field.Name), strings.ToLower(query)) {
+			candidates = append(candidates, field)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("unable to locate any field with name containing: %s", query)
+	case 1:
+		return candidates[0], nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, field := range candidates {
+		names = append(names, field.Name)
+	}
+	return nil, fmt.Errorf("more than one field with name containing %q, use a more specific query or the name instead: %s", query, strings.Join(names, ", "))
+}
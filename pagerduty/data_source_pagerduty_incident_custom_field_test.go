@@ -2,10 +2,12 @@ package pagerduty
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func TestAccDataSourcePagerDutyIncidentCustomField(t *testing.T) {
@@ -30,6 +32,83 @@ func TestAccDataSourcePagerDutyIncidentCustomField(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyIncidentCustomField_Query(t *testing.T) {
+	suffix := acctest.RandString(5)
+	fieldName := fmt.Sprintf("tf_%s_environment", suffix)
+	dataSourceName := "data.pagerduty_incident_custom_field.by_query"
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFieldTests(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyIncidentCustomFieldQueryConfig(fieldName, strings.ToUpper(suffix)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "pagerduty_incident_custom_field.input", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", fieldName),
+				),
+			},
+		},
+	})
+}
+
+func TestFindIncidentCustomField(t *testing.T) {
+	fields := []*pagerduty.IncidentCustomField{
+		{ID: "PFIELD1", Name: "environment"},
+		{ID: "PFIELD2", Name: "customer_tier"},
+		{ID: "PFIELD3", Name: "customer_region"},
+	}
+
+	cases := []struct {
+		name    string
+		query   string
+		want    string
+		wantErr string
+	}{
+		{name: "environment", want: "PFIELD1"},
+		{name: "Environment", wantErr: "unable to locate"},
+		{query: "ENVIRON", want: "PFIELD1"},
+		{query: "tier", want: "PFIELD2"},
+		{query: "customer", wantErr: "customer_tier, customer_region"},
+		{query: "severity", wantErr: "unable to locate"},
+	}
+
+	for _, c := range cases {
+		found, err := findIncidentCustomField(fields, c.name, c.query)
+		if c.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("name %q query %q: expected an error containing %q, got %v", c.name, c.query, c.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("name %q query %q: unexpected error: %v", c.name, c.query, err)
+			continue
+		}
+		if found.ID != c.want {
+			t.Errorf("name %q query %q: expected field %s, got %s", c.name, c.query, c.want, found.ID)
+		}
+	}
+}
+
+func testAccDataSourcePagerDutyIncidentCustomFieldQueryConfig(name, query string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "input" {
+  name = "%[1]s"
+  display_name = "%[1]s"
+  data_type = "string"
+  field_type = "single_value"
+}
+
+data "pagerduty_incident_custom_field" "by_query" {
+  query = "%[2]s"
+  depends_on = [pagerduty_incident_custom_field.input]
+}
+`, name, query)
+}
+
 func testAccDataSourcePagerDutyIncidentCustomFieldConfig(name string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_incident_custom_field" "input" {
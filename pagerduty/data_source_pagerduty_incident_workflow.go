@@ -40,7 +40,7 @@ func dataSourcePagerDutyIncidentWorkflowRead(ctx context.Context, d *schema.Reso
 
 	searchName := d.Get("name").(string)
 
-	err = retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.IncidentWorkflows.ListContext(ctx, &pagerduty.ListIncidentWorkflowOptions{})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
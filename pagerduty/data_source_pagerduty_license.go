@@ -88,7 +88,7 @@ func dataSourcePagerDutyLicenseRead(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Fetching PagerDuty Licenses")
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		licenses, _, err := client.Licenses.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -40,7 +40,7 @@ func dataSourcePagerDutyLicensesRead(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Fetching PagerDuty Licenses")
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		licenses, _, err := client.Licenses.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
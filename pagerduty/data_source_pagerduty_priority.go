@@ -40,7 +40,7 @@ func dataSourcePagerDutyPriorityRead(d *schema.ResourceData, meta interface{}) e
 
 	searchTeam := d.Get("name").(string)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.Priorities.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
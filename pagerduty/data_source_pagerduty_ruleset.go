@@ -41,7 +41,7 @@ func dataSourcePagerDutyRulesetRead(d *schema.ResourceData, meta interface{}) er
 
 	searchName := d.Get("name").(string)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.Rulesets.List()
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -1,11 +1,13 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"time"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -20,6 +22,30 @@ func dataSourcePagerDutySchedule() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"include_on_call": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"on_call_users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -34,41 +60,89 @@ func dataSourcePagerDutyScheduleRead(d *schema.ResourceData, meta interface{}) e
 
 	searchName := d.Get("name").(string)
 
+	var found *pagerduty.Schedule
 	o := &pagerduty.ListSchedulesOptions{
 		Query: searchName,
+		Limit: apiutil.Limit,
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	// Delaying retry by 30s as recommended by PagerDuty
+	// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+	opts := apiutil.AllOptions{
+		Timeout:        retryTimeLong(meta),
+		Delay:          30 * time.Second,
+		IsNonRetryable: isBadRequestErr,
+	}
+	err = apiutil.AllPages(context.Background(), opts, func(offset int) (int, bool, error) {
+		o.Offset = offset
 		resp, _, err := client.Schedules.List(o)
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
-				return retry.NonRetryableError(err)
-			}
-
-			// Delaying retry by 30s as recommended by PagerDuty
-			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
-			time.Sleep(30 * time.Second)
-			return retry.RetryableError(err)
+			return 0, false, err
 		}
 
-		var found *pagerduty.Schedule
-
 		for _, schedule := range resp.Schedules {
 			if schedule.Name == searchName {
 				found = schedule
-				break
+				return len(resp.Schedules), false, nil
 			}
 		}
+		return len(resp.Schedules), resp.More, nil
+	})
+	if err != nil {
+		return err
+	}
 
-		if found == nil {
-			return retry.NonRetryableError(
-				fmt.Errorf("Unable to locate any schedule with the name: %s", searchName),
-			)
-		}
+	if found == nil {
+		return fmt.Errorf("Unable to locate any schedule with the name: %s", searchName)
+	}
 
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
+	d.SetId(found.ID)
+	d.Set("name", found.Name)
 
+	if !d.Get("include_on_call").(bool) {
+		return nil
+	}
+
+	users, err := fetchScheduleOnCallUsers(client, meta, found.ID)
+	if err != nil {
+		return err
+	}
+	return d.Set("on_call_users", flattenScheduleOnCallUsers(users))
+}
+
+// fetchScheduleOnCallUsers lists the users on call right now in the schedule
+// with id `scheduleID`.
+func fetchScheduleOnCallUsers(client *pagerduty.Client, meta interface{}, scheduleID string) ([]*pagerduty.User, error) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	o := &pagerduty.ListOnCallsOptions{Since: now, Until: now}
+
+	var users []*pagerduty.User
+	err := retry.Retry(retryTime(meta), func() *retry.RetryError {
+		resp, _, err := client.Schedules.ListOnCalls(scheduleID, o)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		users = resp.Users
 		return nil
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing on-call users of schedule %s: %w", scheduleID, err)
+	}
+	return users, nil
+}
+
+func flattenScheduleOnCallUsers(users []*pagerduty.User) []map[string]interface{} {
+	res := make([]map[string]interface{}, 0, len(users))
+	for _, u := range users {
+		res = append(res, map[string]interface{}{
+			"id":    u.ID,
+			"name":  u.Name,
+			"email": u.Email,
+		})
+	}
+	return res
 }
@@ -2,14 +2,66 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func TestDataSourcePagerDutyScheduleReadSecondPage(t *testing.T) {
+	config := newTestConfig(t, &Config{}, twoPageListHandler("/schedules", "schedules",
+		`{"id": "PFIRST1", "name": "Primary Rotation"}`,
+		`{"id": "PSECOND", "name": "Primary"}`))
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutySchedule().Schema, map[string]interface{}{"name": "Primary"})
+
+	if err := dataSourcePagerDutyScheduleRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Id() != "PSECOND" {
+		t.Errorf("expected the schedule on the second page, got %q", d.Id())
+	}
+}
+
+func TestDataSourcePagerDutyScheduleReadOnCallUsers(t *testing.T) {
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/schedules":
+			fmt.Fprint(w, `{"schedules": [{"id": "PSCHEDU", "name": "Primary"}], "more": false}`)
+		case "/schedules/PSCHEDU/users":
+			if r.URL.Query().Get("since") == "" || r.URL.Query().Get("since") != r.URL.Query().Get("until") {
+				t.Errorf("expected the on-call users of a single instant, got %q", r.URL.RawQuery)
+			}
+			fmt.Fprint(w, `{"users": [{"id": "PUSER01", "name": "Alice", "email": "alice@foo.test"}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+		}
+	})
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutySchedule().Schema, map[string]interface{}{
+		"name":            "Primary",
+		"include_on_call": true,
+	})
+
+	if err := dataSourcePagerDutyScheduleRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Get("on_call_users.#").(int) != 1 {
+		t.Fatalf("expected one on-call user, got %v", d.Get("on_call_users"))
+	}
+	if id := d.Get("on_call_users.0.id").(string); id != "PUSER01" {
+		t.Errorf("expected on-call user PUSER01, got %q", id)
+	}
+	if email := d.Get("on_call_users.0.email").(string); email != "alice@foo.test" {
+		t.Errorf("expected on-call user email alice@foo.test, got %q", email)
+	}
+}
+
 func TestAccDataSourcePagerDutySchedule_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -26,6 +78,8 @@ func TestAccDataSourcePagerDutySchedule_Basic(t *testing.T) {
 				Config: testAccDataSourcePagerDutyScheduleConfig(username, email, schedule, location, start, rotationVirtualStart),
 				Check: resource.ComposeTestCheckFunc(
 					testAccDataSourcePagerDutySchedule("pagerduty_schedule.test", "data.pagerduty_schedule.by_name"),
+					// The schedule's only layer starts tomorrow, so nobody is on call yet.
+					resource.TestCheckResourceAttr("data.pagerduty_schedule.by_name", "on_call_users.#", "0"),
 				),
 			},
 		},
@@ -86,7 +140,8 @@ resource "pagerduty_schedule" "test" {
 }
 
 data "pagerduty_schedule" "by_name" {
-  name = pagerduty_schedule.test.name
+  name            = pagerduty_schedule.test.name
+  include_on_call = true
 }
 `, username, email, schedule, location, start, rotationVirtualStart)
 }
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -83,7 +82,7 @@ func dataSourcePagerDutyServiceRead(d *schema.ResourceData, meta interface{}) er
 	}
 	foundServices := make([]*pagerduty.Service, 0)
 
-	retryErr := retry.Retry(5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		for more {
 			o.Offset = lookupOffset
 			resp, _, err := client.Services.List(o)
@@ -51,7 +51,7 @@ func dataSourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta inte
 		Query: searchName,
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.Services.List(o)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -1,12 +1,12 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"net/http"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
@@ -47,44 +47,47 @@ func dataSourcePagerDutyTeamRead(d *schema.ResourceData, meta interface{}) error
 
 	searchTeam := d.Get("name").(string)
 
+	var found *pagerduty.Team
 	o := &pagerduty.ListTeamsOptions{
 		Query: searchTeam,
+		Limit: apiutil.Limit,
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	// Delaying retry by 30s as recommended by PagerDuty
+	// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
+	opts := apiutil.AllOptions{
+		Timeout:        retryTimeLong(meta),
+		Delay:          30 * time.Second,
+		IsNonRetryable: isBadRequestErr,
+	}
+	err = apiutil.AllPages(context.Background(), opts, func(offset int) (int, bool, error) {
+		o.Offset = offset
 		resp, _, err := client.Teams.List(o)
 		if err != nil {
-			if isErrCode(err, http.StatusBadRequest) {
-				return retry.NonRetryableError(err)
-			}
-
-			// Delaying retry by 30s as recommended by PagerDuty
-			// https://developer.pagerduty.com/docs/rest-api-v2/rate-limiting/#what-are-possible-workarounds-to-the-events-api-rate-limit
-			time.Sleep(30 * time.Second)
-			return retry.RetryableError(err)
+			return 0, false, err
 		}
 
-		var found *pagerduty.Team
-
 		for _, team := range resp.Teams {
 			if team.Name == searchTeam {
 				found = team
-				break
+				return len(resp.Teams), false, nil
 			}
 		}
+		return len(resp.Teams), resp.More, nil
+	})
+	if err != nil {
+		return err
+	}
 
-		if found == nil {
-			return retry.NonRetryableError(
-				fmt.Errorf("Unable to locate any team with name: %s", searchTeam),
-			)
-		}
+	if found == nil {
+		return fmt.Errorf("Unable to locate any team with name: %s", searchTeam)
+	}
 
-		d.SetId(found.ID)
-		d.Set("name", found.Name)
-		d.Set("description", found.Description)
-		d.Set("parent", found.Parent)
-		d.Set("default_role", found.DefaultRole)
+	d.SetId(found.ID)
+	d.Set("name", found.Name)
+	d.Set("description", found.Description)
+	d.Set("parent", found.Parent)
+	d.Set("default_role", found.DefaultRole)
 
-		return nil
-	})
+	return nil
 }
@@ -62,7 +62,7 @@ func dataSourcePagerDutyTeamMembersRead(ctx context.Context, d *schema.ResourceD
 
 	log.Printf("[INFO] Reading PagerDuty team members of %s", teamID)
 
-	retryErr := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
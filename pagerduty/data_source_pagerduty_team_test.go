@@ -4,11 +4,26 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func TestDataSourcePagerDutyTeamReadSecondPage(t *testing.T) {
+	config := newTestConfig(t, &Config{}, twoPageListHandler("/teams", "teams",
+		`{"id": "PFIRST1", "name": "Platform Ops"}`,
+		`{"id": "PSECOND", "name": "Platform"}`))
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutyTeam().Schema, map[string]interface{}{"name": "Platform"})
+
+	if err := dataSourcePagerDutyTeamRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Id() != "PSECOND" {
+		t.Errorf("expected the team on the second page, got %q", d.Id())
+	}
+}
+
 func TestAccDataSourcePagerDutyTeam_Basic(t *testing.T) {
 	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	parent := fmt.Sprintf("tf-%s", acctest.RandString(5))
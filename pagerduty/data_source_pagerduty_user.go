@@ -58,7 +58,7 @@ func dataSourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error
 		Query: searchEmail,
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, err := client.Users.ListAll(o)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -20,15 +20,32 @@ func dataSourcePagerDutyUserContactMethod() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The ID of the contact method to find in the PagerDuty API",
+				ExactlyOneOf: []string{"id", "label"},
+			},
 			"label": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The name of the contact method to find in the PagerDuty API",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The name of the contact method to find in the PagerDuty API",
+				RequiredWith: []string{"type"},
 			},
 			"type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The type of the contact method",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				Description:   "The type of the contact method",
+				RequiredWith:  []string{"label"},
+				ConflictsWith: []string{"id"},
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"email_contact_method",
+					"phone_contact_method",
+					"push_notification_contact_method",
+					"sms_contact_method",
+				}),
 			},
 
 			"address": {
@@ -71,7 +88,11 @@ func dataSourcePagerDutyUserContactMethodRead(d *schema.ResourceData, meta inter
 	searchLabel := d.Get("label").(string)
 	searchType := d.Get("type").(string)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	if id := d.Get("id").(string); id != "" {
+		return getUserContactMethodByID(d, meta, client, userId, id)
+	}
+
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, _, err := client.Users.ListContactMethods(userId)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -98,19 +119,51 @@ func dataSourcePagerDutyUserContactMethodRead(d *schema.ResourceData, meta inter
 		}
 
 		if found == nil {
-			return retry.NonRetryableError(fmt.Errorf("Unable to locate any contact methods with the label: %s", searchLabel))
+			return retry.NonRetryableError(fmt.Errorf("Unable to locate any contact methods with the label: %s and type: %s", searchLabel, searchType))
 		}
 
 		d.SetId(found.ID)
-		d.Set("address", found.Address)
-		d.Set("blacklisted", found.BlackListed)
-		d.Set("country_code", found.CountryCode)
-		d.Set("device_type", found.DeviceType)
-		d.Set("enabled", found.Enabled)
-		d.Set("label", found.Label)
-		d.Set("send_short_email", found.SendShortEmail)
-		d.Set("type", found.Type)
+		setUserContactMethodDataSourceProps(d, found)
 
 		return nil
 	})
 }
+
+func getUserContactMethodByID(d *schema.ResourceData, meta interface{}, client *pagerduty.Client, userId, id string) error {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
+		found, _, err := client.Users.GetContactMethod(userId, id)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) {
+				return retry.NonRetryableError(fmt.Errorf("Unable to locate any contact method with the id: %s: %w", id, err))
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+
+		d.SetId(found.ID)
+		setUserContactMethodDataSourceProps(d, found)
+
+		return nil
+	})
+}
+
+// setUserContactMethodDataSourceProps sets the attributes of the contact
+// method found. Attributes which don't apply to its type are not set, so they
+// are null instead of holding a misleading zero value.
+func setUserContactMethodDataSourceProps(d *schema.ResourceData, cm *pagerduty.ContactMethod) {
+	d.Set("address", cm.Address)
+	d.Set("label", cm.Label)
+	d.Set("type", cm.Type)
+
+	switch cm.Type {
+	case "email_contact_method":
+		d.Set("send_short_email", cm.SendShortEmail)
+	case "phone_contact_method", "sms_contact_method":
+		d.Set("blacklisted", cm.BlackListed)
+		d.Set("country_code", cm.CountryCode)
+		d.Set("enabled", cm.Enabled)
+	case "push_notification_contact_method":
+		d.Set("device_type", cm.DeviceType)
+	}
+}
@@ -2,11 +2,17 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func TestAccDataSourcePagerDutyUserContactMethod_Basic(t *testing.T) {
@@ -30,6 +36,61 @@ func TestAccDataSourcePagerDutyUserContactMethod_Basic(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyUserContactMethod_ByID(t *testing.T) {
+	name := fmt.Sprintf("%s %s", acctest.RandString(8), acctest.RandString(10))
+	address := fmt.Sprintf("%s@%s.com", acctest.RandString(6), acctest.RandString(7))
+	second_address := fmt.Sprintf("%s@%s.com", acctest.RandString(6), acctest.RandString(7))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyUserContactMethodByIDConfig(name, address, second_address),
+				Check: resource.ComposeTestCheckFunc(
+					testAccDataSourcePagerDutyUserContactMethod("pagerduty_user_contact_method.test", "data.pagerduty_user_contact_method.by_id"),
+					resource.TestCheckResourceAttr("data.pagerduty_user_contact_method.by_id", "label", "Work"),
+					resource.TestCheckResourceAttr("data.pagerduty_user_contact_method.by_id", "type", "email_contact_method"),
+					resource.TestCheckResourceAttr("data.pagerduty_user_contact_method.by_id", "address", second_address),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyUserContactMethod_IDAndLabel(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "pagerduty_user_contact_method" "invalid" {
+  id      = "PXXXXXX"
+  label   = "Work"
+  user_id = "PXXXXXX"
+  type    = "email_contact_method"
+}
+`,
+				ExpectError: regexp.MustCompile(`only one of .id,label. can be specified`),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyUserContactMethod_InvalidType(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyUserContactMethodInvalidTypeConfig(),
+				ExpectError: regexp.MustCompile(`"email" is an invalid value`),
+			},
+		},
+	})
+}
+
 func testAccDataSourcePagerDutyUserContactMethod(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -79,3 +140,116 @@ data "pagerduty_user_contact_method" "by_summary_type_and_user_id" {
 }
 `, name, method_type, address, second_address, label)
 }
+
+func testAccDataSourcePagerDutyUserContactMethodInvalidTypeConfig() string {
+	return `
+data "pagerduty_user_contact_method" "invalid_type" {
+  label   = "Work"
+  user_id = "PXXXXXX"
+  type    = "email"
+}
+`
+}
+
+func TestDataSourcePagerDutyUserContactMethodReadMatchesLabelAndType(t *testing.T) {
+	config := newTestConfig(t, &Config{RetryTimeLong: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"contact_methods": [
+			{"id": "PEMAIL1", "type": "email_contact_method", "label": "Work", "address": "foo@example.com"},
+			{"id": "PPHONE1", "type": "phone_contact_method", "label": "Home", "address": "4153333333", "country_code": 1},
+			{"id": "PPHONE2", "type": "phone_contact_method", "label": "Work", "address": "4154444444", "country_code": 1}
+		]}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, dataSourcePagerDutyUserContactMethod().Schema, map[string]interface{}{
+		"user_id": "PUSER01",
+		"label":   "Work",
+		"type":    "phone_contact_method",
+	})
+	if err := dataSourcePagerDutyUserContactMethodRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if d.Id() != "PPHONE2" {
+		t.Errorf("expected the Work phone contact method, got %q", d.Id())
+	}
+
+	d = schema.TestResourceDataRaw(t, dataSourcePagerDutyUserContactMethod().Schema, map[string]interface{}{
+		"user_id": "PUSER01",
+		"label":   "Home",
+		"type":    "sms_contact_method",
+	})
+	err := dataSourcePagerDutyUserContactMethodRead(d, config)
+	if err == nil || !strings.Contains(err.Error(), "label: Home and type: sms_contact_method") {
+		t.Errorf("expected a not found error naming the label and type, got: %v", err)
+	}
+}
+
+func TestSetUserContactMethodDataSourceProps(t *testing.T) {
+	cases := []struct {
+		cm      *pagerduty.ContactMethod
+		present []string
+		null    []string
+	}{
+		{
+			cm:      &pagerduty.ContactMethod{Type: "email_contact_method", Address: "foo@example.com", SendShortEmail: true},
+			present: []string{"send_short_email"},
+			null:    []string{"blacklisted", "country_code", "device_type", "enabled"},
+		},
+		{
+			cm:      &pagerduty.ContactMethod{Type: "phone_contact_method", Address: "4153333333", CountryCode: 1},
+			present: []string{"blacklisted", "country_code", "enabled"},
+			null:    []string{"device_type", "send_short_email"},
+		},
+		{
+			cm:      &pagerduty.ContactMethod{Type: "sms_contact_method", Address: "4153333333", CountryCode: 1, Enabled: true},
+			present: []string{"blacklisted", "country_code", "enabled"},
+			null:    []string{"device_type", "send_short_email"},
+		},
+		{
+			cm:      &pagerduty.ContactMethod{Type: "push_notification_contact_method", Address: "token", DeviceType: "ios"},
+			present: []string{"device_type"},
+			null:    []string{"blacklisted", "country_code", "enabled", "send_short_email"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.cm.Type, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourcePagerDutyUserContactMethod().Schema, map[string]interface{}{})
+			d.SetId("PXXXXXX")
+			setUserContactMethodDataSourceProps(d, c.cm)
+
+			attrs := d.State().Attributes
+			for _, k := range c.present {
+				if _, ok := attrs[k]; !ok {
+					t.Errorf("expected %q to be set for %s", k, c.cm.Type)
+				}
+			}
+			for _, k := range c.null {
+				if v, ok := attrs[k]; ok {
+					t.Errorf("expected %q to be null for %s, got %q", k, c.cm.Type, v)
+				}
+			}
+		})
+	}
+}
+
+func testAccDataSourcePagerDutyUserContactMethodByIDConfig(name, address, second_address string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[2]v"
+}
+
+resource "pagerduty_user_contact_method" "test" {
+  user_id = pagerduty_user.foo.id
+  type    = "email_contact_method"
+  address = "%[3]v"
+  label   = "Work"
+}
+
+data "pagerduty_user_contact_method" "by_id" {
+  id      = pagerduty_user_contact_method.test.id
+  user_id = pagerduty_user.foo.id
+}
+`, name, address, second_address)
+}
@@ -86,7 +86,7 @@ func dataSourcePagerDutyUsersRead(d *schema.ResourceData, meta interface{}) erro
 		TeamIDs: teamIds,
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		resp, err := client.Users.ListAll(o)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -5,8 +5,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
-	"time"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -15,8 +15,9 @@ import (
 
 var resourceEventOrchestrationCacheVariableConditionSchema = map[string]*schema.Schema{
 	"expression": {
-		Type:     schema.TypeString,
-		Required: true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validate.ConditionExpressionDiagFunc,
 	},
 }
 
@@ -69,6 +70,25 @@ var dataSourceEventOrchestrationCacheVariableConfigurationSchema = map[string]*s
 	},
 }
 
+// eventOrchestrationCacheVariableUpdatedBySchema is the user who last
+// modified a Cache Variable, as reported by the API.
+var eventOrchestrationCacheVariableUpdatedBySchema = &schema.Schema{
+	Type:     schema.TypeList,
+	Computed: true,
+	Elem: &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"summary": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	},
+}
+
 func checkConfiguration(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
 	t := diff.Get("configuration.0.type").(string)
 	s := diff.Get("configuration.0.source").(string)
@@ -102,6 +122,7 @@ func setEventOrchestrationCacheVariableProps(d *schema.ResourceData, cv *pagerdu
 	d.Set("disabled", cv.Disabled)
 	d.Set("configuration", flattenEventOrchestrationCacheVariableConfiguration(cv.Configuration))
 	d.Set("condition", flattenEventOrchestrationCacheVariableConditions(cv.Conditions))
+	d.Set("updated_by", flattenEventOrchestrationCacheVariableUpdatedBy(cv.UpdatedBy))
 
 	return nil
 }
@@ -188,6 +209,18 @@ func flattenEventOrchestrationCacheVariableConditions(conds []*pagerduty.EventOr
 	return flattenedConds
 }
 
+func flattenEventOrchestrationCacheVariableUpdatedBy(ref *pagerduty.UserReference) []map[string]interface{} {
+	if ref == nil {
+		return nil
+	}
+	return []map[string]interface{}{
+		{
+			"id":      ref.ID,
+			"summary": ref.Summary,
+		},
+	}
+}
+
 func flattenEventOrchestrationCacheVariableConfiguration(conf *pagerduty.EventOrchestrationCacheVariableConfiguration) []interface{} {
 	result := map[string]interface{}{
 		"type":        conf.Type,
@@ -222,7 +255,7 @@ func resourceEventOrchestrationCacheVariableImport(ctx context.Context, d *schem
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing cache variable. Expected import ID format: <%s>:<cache_variable_id>", parent_identifier)
 	}
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Cache Variable '%s' for PagerDuty Event Orchestration: %s", id, oid)
 
 		if _, err := fetchPagerDutyEventOrchestrationCacheVariable(ctx, d, meta, cacheVariableType, oid, id); err != nil {
@@ -250,7 +283,7 @@ func resourceEventOrchestrationCacheVariableCreate(ctx context.Context, d *schem
 
 	oid, payload := getEventOrchestrationCacheVariablePayloadData(d, cacheVariableType)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Creating Cache Variable '%s' for PagerDuty Event Orchestration '%s'", payload.Name, oid)
 
 		if cacheVariable, _, err := client.EventOrchestrationCacheVariables.Create(ctx, cacheVariableType, oid, payload); err != nil {
@@ -279,7 +312,7 @@ func resourceEventOrchestrationCacheVariableRead(ctx context.Context, d *schema.
 	id := d.Id()
 	oid := d.Get(getIdentifier(cacheVariableType)).(string)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Cache Variable '%s' for PagerDuty Event Orchestration: %s", id, oid)
 
 		if _, err := fetchPagerDutyEventOrchestrationCacheVariable(ctx, d, meta, cacheVariableType, oid, id); err != nil {
@@ -313,7 +346,7 @@ func resourceEventOrchestrationCacheVariableUpdate(ctx context.Context, d *schem
 	id := d.Id()
 	oid, payload := getEventOrchestrationCacheVariablePayloadData(d, cacheVariableType)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Updating Cache Variable '%s' for PagerDuty Event Orchestration: %s", id, oid)
 		if cacheVariable, _, err := client.EventOrchestrationCacheVariables.Update(ctx, cacheVariableType, oid, id, payload); err != nil {
 			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) || isErrCode(err, http.StatusForbidden) {
@@ -344,7 +377,7 @@ func resourceEventOrchestrationCacheVariableDelete(ctx context.Context, d *schem
 	id := d.Id()
 	oid, _ := getEventOrchestrationCacheVariablePayloadData(d, cacheVariableType)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Deleting Cache Variable '%s' for PagerDuty Event Orchestration: %s", id, oid)
 		if _, err := client.EventOrchestrationCacheVariables.Delete(ctx, cacheVariableType, oid, id); err != nil {
 			if isErrCode(err, http.StatusBadRequest) || isErrCode(err, http.StatusNotFound) || isErrCode(err, http.StatusForbidden) {
@@ -412,7 +445,7 @@ func getEventOrchestrationCacheVariableById(ctx context.Context, d *schema.Resou
 		return diag.FromErr(err)
 	}
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Cache Variable data source by ID '%s' for PagerDuty Event Orchestration '%s'", id, oid)
 
 		if cacheVariable, _, err := client.EventOrchestrationCacheVariables.Get(ctx, cacheVariableType, oid, id); err != nil {
@@ -445,7 +478,7 @@ func getEventOrchestrationCacheVariableByName(ctx context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Cache Variable data source by name '%s' for PagerDuty Event Orchestration '%s'", name, oid)
 
 		resp, _, err := client.EventOrchestrationCacheVariables.List(ctx, cacheVariableType, oid)
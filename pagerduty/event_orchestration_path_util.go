@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -11,8 +12,9 @@ import (
 
 var eventOrchestrationPathConditionsSchema = map[string]*schema.Schema{
 	"expression": {
-		Type:     schema.TypeString,
-		Required: true,
+		Type:             schema.TypeString,
+		Required:         true,
+		ValidateDiagFunc: validate.ConditionExpressionDiagFunc,
 	},
 }
 
@@ -53,3 +53,27 @@ func TestAccPagerDutyIncidentCustomField_import_no_description(t *testing.T) {
 		},
 	})
 }
+
+func TestAccPagerDutyIncidentCustomField_importByName(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFieldTests(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfig(fieldName, "test description", "string"),
+			},
+			{
+				ResourceName:      "pagerduty_incident_custom_field.input",
+				ImportState:       true,
+				ImportStateId:     fieldName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
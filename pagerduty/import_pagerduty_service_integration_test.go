@@ -35,6 +35,113 @@ func TestAccPagerDutyServiceIntegration_import(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceIntegrationVendor_import(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationConfig(username, email, escalationPolicy, service, serviceIntegration),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceIntegrationExists("pagerduty_service_integration.foo"),
+					resource.TestCheckResourceAttrSet("pagerduty_service_integration.foo", "type"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_integration.foo", "vendor", "data.pagerduty_vendor.datadog", "id"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_service_integration.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyServiceIntegrationId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccCheckPagerDutyServiceIntegrationConfig(username, email, escalationPolicy, service, serviceIntegration),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyServiceIntegrationEventsAPIV2_importWithoutType(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	serviceIntegration := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceIntegrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceIntegrationEventsAPIV2Config(username, email, escalationPolicy, service, serviceIntegration, `type = "events_api_v2_inbound_integration"`),
+			},
+			{
+				ResourceName:      "pagerduty_service_integration.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyServiceIntegrationId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:   testAccCheckPagerDutyServiceIntegrationEventsAPIV2Config(username, email, escalationPolicy, service, serviceIntegration, ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceIntegrationEventsAPIV2Config(username, email, escalationPolicy, service, serviceIntegration, integrationType string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%s"
+  email       = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%s"
+  description = "foo"
+  num_loops   = 1
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%s"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_service_integration" "foo" {
+  name    = "%s"
+  service = pagerduty_service.foo.id
+  %s
+}
+`, username, email, escalationPolicy, service, serviceIntegration, integrationType)
+}
+
 func testAccCheckPagerDutyServiceIntegrationId(s *terraform.State) (string, error) {
 	return fmt.Sprintf("%v.%v", s.RootModule().Resources["pagerduty_service.foo"].Primary.ID, s.RootModule().Resources["pagerduty_service_integration.foo"].Primary.ID), nil
 }
@@ -159,3 +159,22 @@ func convertIncidentCustomFieldValueForFlatten(value interface{}, multiValue boo
 		return fmt.Sprintf("%v", value), nil
 	}
 }
+
+// flattenIncidentCustomFieldValue renders a single integer, float or boolean
+// value the way it's parsed back, e.g. "3.14" or "true", rather than in
+// exponent notation.
+func flattenIncidentCustomFieldValue(value interface{}, datatype pagerduty.IncidentCustomFieldDataType, multiValue bool) (string, error) {
+	if !multiValue {
+		switch v := value.(type) {
+		case float64:
+			if datatype == pagerduty.IncidentCustomFieldDataTypeInt || datatype == pagerduty.IncidentCustomFieldDataTypeFloat {
+				return strconv.FormatFloat(v, 'f', -1, 64), nil
+			}
+		case bool:
+			if datatype == pagerduty.IncidentCustomFieldDataTypeBool {
+				return strconv.FormatBool(v), nil
+			}
+		}
+	}
+	return convertIncidentCustomFieldValueForFlatten(value, multiValue)
+}
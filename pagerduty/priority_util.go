@@ -0,0 +1,167 @@
+package pagerduty
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// hasPriorityNames reports whether any of the configured priority values is
+// a priority name, i.e. anything but the star wildcard and priority ids. Only
+// names need the priorities of the account to be looked up.
+func hasPriorityNames(values []string) bool {
+	for _, v := range values {
+		if v != StarWildcardConfig && !isPagerDutyID(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// findPriority returns the priority referenced by `v`, which can be either a
+// priority id or a priority name.
+func findPriority(priorities []*pagerduty.Priority, v string) *pagerduty.Priority {
+	for _, p := range priorities {
+		if p.ID == v {
+			return p
+		}
+	}
+	for _, p := range priorities {
+		if strings.EqualFold(p.Name, v) {
+			return p
+		}
+	}
+	return nil
+}
+
+// isTransientError reports whether `err` is an error response from the
+// PagerDuty API which is worth retrying.
+func isTransientError(err error) bool {
+	var e *pagerduty.Error
+	if !errors.As(err, &e) || e.ErrorResponse == nil || e.ErrorResponse.Response == nil {
+		return false
+	}
+	code := e.ErrorResponse.Response.StatusCode
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// lookupPriorities returns the priorities of the account. The list is cached
+// in the provider configuration, so it is requested only once.
+func lookupPriorities(meta interface{}) ([]*pagerduty.Priority, error) {
+	config := meta.(*Config)
+
+	config.lookupMu.Lock()
+	priorities := config.priorities
+	config.lookupMu.Unlock()
+	if priorities != nil {
+		return priorities, nil
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return nil, err
+	}
+
+	priorities, err = fetchPriorities(client, meta)
+	if err != nil {
+		return nil, err
+	}
+	if priorities == nil {
+		priorities = []*pagerduty.Priority{}
+	}
+
+	config.lookupMu.Lock()
+	config.priorities = priorities
+	config.lookupMu.Unlock()
+
+	return priorities, nil
+}
+
+func fetchPriorities(client *pagerduty.Client, meta interface{}) ([]*pagerduty.Priority, error) {
+	var priorities []*pagerduty.Priority
+
+	err := retry.Retry(retryTime(meta), func() *retry.RetryError {
+		resp, _, err := client.Priorities.List()
+		if err != nil {
+			var e *pagerduty.Error
+			if errors.As(err, &e) && !isTransientError(err) {
+				return retry.NonRetryableError(err)
+			}
+
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+		priorities = resp.Priorities
+		return nil
+	})
+
+	return priorities, err
+}
+
+// resolvePriorityIDs replaces the priority names in `values` with their
+// corresponding priority id, validating every value against the priorities of
+// the account. Values with only ids and the star wildcard are kept as they
+// are, without looking the priorities up.
+func resolvePriorityIDs(meta interface{}, values []string) ([]string, error) {
+	if !hasPriorityNames(values) {
+		return values, nil
+	}
+
+	priorities, err := lookupPriorities(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == StarWildcardConfig {
+			ids = append(ids, v)
+			continue
+		}
+
+		found := findPriority(priorities, v)
+		if found == nil {
+			return nil, fmt.Errorf("Unable to locate any priority with id or name: %s", v)
+		}
+		ids = append(ids, found.ID)
+	}
+
+	return ids, nil
+}
+
+// flattenPriorityIDsLike replaces the priority ids in `ids` with the priority
+// names used in `prior` for them, so a configuration referencing priorities
+// by name does not produce a diff against the ids returned by the API.
+func flattenPriorityIDsLike(meta interface{}, ids, prior []string) ([]string, error) {
+	if !hasPriorityNames(prior) {
+		return ids, nil
+	}
+
+	priorities, err := lookupPriorities(meta)
+	if err != nil {
+		return nil, err
+	}
+
+	nameByID := make(map[string]string)
+	for _, v := range prior {
+		if p := findPriority(priorities, v); p != nil && p.ID != v {
+			nameByID[p.ID] = v
+		}
+	}
+
+	flattened := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if name, ok := nameByID[id]; ok {
+			flattened = append(flattened, name)
+			continue
+		}
+		flattened = append(flattened, id)
+	}
+
+	return flattened, nil
+}
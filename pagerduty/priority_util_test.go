@@ -0,0 +1,92 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestResolvePriorityIDs(t *testing.T) {
+	var requests int
+	config := newTestConfig(t, &Config{}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"priorities": [{"id": "PPRIO01", "name": "P1"}, {"id": "PPRIO02", "name": "P2"}]}`)
+	})
+
+	got, err := resolvePriorityIDs(config, []string{"PPRIO01", "p2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"PPRIO01", "PPRIO02"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if _, err := resolvePriorityIDs(config, []string{"PPRIO01", "P9"}); err == nil {
+		t.Errorf("expected an error for a value matching no priority")
+	}
+
+	flattened, err := flattenPriorityIDsLike(config, []string{"PPRIO01", "PPRIO02"}, []string{"PPRIO01", "p2"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"PPRIO01", "p2"}; !reflect.DeepEqual(flattened, want) {
+		t.Errorf("expected %v, got %v", want, flattened)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected the priorities to be requested once, got %d requests", requests)
+	}
+}
+
+// Test the priorities aren't looked up when only ids and the star wildcard
+// are used
+func TestResolvePriorityIDsWithoutNames(t *testing.T) {
+	config := newTestConfig(t, &Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	values := []string{"PPRIO01", "PPRIO02"}
+	got, err := resolvePriorityIDs(config, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, values) {
+		t.Errorf("expected %v, got %v", values, got)
+	}
+
+	if _, err := resolvePriorityIDs(config, []string{StarWildcardConfig}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	flattened, err := flattenPriorityIDsLike(config, values, values)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(flattened, values) {
+		t.Errorf("expected %v, got %v", values, flattened)
+	}
+}
+
+func TestResolvePriorityIDsNonTransientError(t *testing.T) {
+	var requests int
+	config := newTestConfig(t, &Config{}, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"error": {"code": 2010, "message": "Access Denied"}}`)
+	})
+
+	_, err := resolvePriorityIDs(config, []string{"P1"})
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if isTransientError(err) {
+		t.Errorf("expected %v to not be transient", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected a single request, got %d", requests)
+	}
+}
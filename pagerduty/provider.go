@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -41,6 +43,12 @@ func Provider(isMux bool) *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("PAGERDUTY_USER_TOKEN", nil),
 			},
 
+			"user_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("PAGERDUTY_USER_EMAIL", nil),
+			},
+
 			"service_region": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -83,6 +91,29 @@ func Provider(isMux bool) *schema.Provider {
 				Optional: true,
 				Default:  false,
 			},
+
+			"insecure_tls_hosts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"retry_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"retry_timeout_long": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"request_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -133,7 +164,6 @@ func Provider(isMux bool) *schema.Provider {
 			"pagerduty_slack_connection":                              resourcePagerDutySlackConnection(),
 			"pagerduty_business_service_subscriber":                   resourcePagerDutyBusinessServiceSubscriber(),
 			"pagerduty_webhook_subscription":                          resourcePagerDutyWebhookSubscription(),
-			"pagerduty_event_orchestration":                           resourcePagerDutyEventOrchestration(),
 			"pagerduty_event_orchestration_integration":               resourcePagerDutyEventOrchestrationIntegration(),
 			"pagerduty_event_orchestration_global":                    resourcePagerDutyEventOrchestrationPathGlobal(),
 			"pagerduty_event_orchestration_router":                    resourcePagerDutyEventOrchestrationPathRouter(),
@@ -162,6 +192,10 @@ func Provider(isMux bool) *schema.Provider {
 		delete(p.ResourcesMap, "pagerduty_business_service")
 	}
 
+	// Planning an event orchestration looks up team names, which needs the
+	// configuration of this provider
+	p.ResourcesMap["pagerduty_event_orchestration"] = resourcePagerDutyEventOrchestration(p.Meta)
+
 	p.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		terraformVersion := p.TerraformVersion
 		if terraformVersion == "" {
@@ -183,6 +217,12 @@ func isErrCode(err error, code int) bool {
 	return false
 }
 
+// isBadRequestErr reports whether `err` is a bad request response from the
+// PagerDuty API, which is not worth retrying.
+func isBadRequestErr(err error) bool {
+	return isErrCode(err, http.StatusBadRequest)
+}
+
 func isMalformedNotFoundError(err error) bool {
 	// There are some errors that doesn't stick to expected error interface and
 	// fallback to a simple text error message that can be capture by this regexp.
@@ -233,10 +273,28 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 		SkipCredsValidation: data.Get("skip_credentials_validation").(bool),
 		Token:               data.Get("token").(string),
 		UserToken:           data.Get("user_token").(string),
+		UserEmail:           data.Get("user_email").(string),
 		UserAgent:           fmt.Sprintf("(%s %s) Terraform/%s", runtime.GOOS, runtime.GOARCH, terraformVersion),
 		ApiUrlOverride:      data.Get("api_url_override").(string),
 		ServiceRegion:       serviceRegion,
 		InsecureTls:         data.Get("insecure_tls").(bool),
+		InsecureTlsHosts:    expandStringList(data.Get("insecure_tls_hosts").([]interface{})),
+	}
+
+	for attr, dst := range map[string]*time.Duration{
+		"retry_timeout":      &config.RetryTime,
+		"retry_timeout_long": &config.RetryTimeLong,
+		"request_timeout":    &config.RequestTimeout,
+	} {
+		v, ok := data.GetOk(attr)
+		if !ok {
+			continue
+		}
+		d, err := time.ParseDuration(v.(string))
+		if err != nil || d <= 0 {
+			return nil, diag.Errorf("%q must be a positive duration such as \"2m\" or \"90s\", got %q", attr, v)
+		}
+		*dst = d
 	}
 
 	useAuthTokenType := pagerduty.AuthTokenTypeAPIToken
@@ -256,6 +314,22 @@ func providerConfigureContextFunc(_ context.Context, data *schema.ResourceData,
 
 	config.APITokenType = &useAuthTokenType
 
+	if config.requestTimeout() >= retryTime(&config) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "`request_timeout` is not less than `retry_timeout`",
+			Detail:   fmt.Sprintf(requestTimeoutWarning, config.requestTimeout(), retryTime(&config)),
+		})
+	}
+
+	if config.InsecureTls {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "`insecure_tls` disables TLS certificate verification",
+			Detail:   insecureTLSWarning,
+		})
+	}
+
 	log.Println("[INFO] Initializing PagerDuty client")
 	return &config, diags
 }
@@ -276,6 +350,10 @@ func expandAppOauthTokenParams(v interface{}) *persistentconfig.AppOauthScopedTo
 	return aotp
 }
 
+var insecureTLSWarning = "PagerDuty Provider has been set to skip the verification of TLS certificates for every host, so any host able to intercept its requests can impersonate the PagerDuty API and read the credentials they carry. It is recommended to use it only for testing, or to list the hosts that need it in `insecure_tls_hosts` instead."
+
+var requestTimeoutWarning = "A single request to the PagerDuty API can take up to %v, while requests are only retried for up to %v, so a request that hangs leaves no time to retry it. Set `request_timeout` well below `retry_timeout`."
+
 var validationAuthMethodConfigWarning = "PagerDuty Provider has been set to authenticate API calls utilizing API token and App Oauth token at same time, in this scenario the use of App Oauth token is prioritised over API token authentication configuration. It is recommended to explicitely set just one authentication method.\nWe also suggest you to check your environment variables in case `token` being automatically read by Provider configuration through `PAGERDUTY_TOKEN` environment variable."
 
 func validateAuthMethodConfig(data *schema.ResourceData) error {
@@ -1,14 +1,19 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -40,6 +45,118 @@ func TestProviderImpl(t *testing.T) {
 	var _ *schema.Provider = Provider(IsNotMuxed)
 }
 
+func TestProviderConfigureRetryTimeouts(t *testing.T) {
+	p := Provider(IsNotMuxed)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"token":                       "foo",
+		"skip_credentials_validation": true,
+		"retry_timeout":               "45s",
+		"retry_timeout_long":          "3m",
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := retryTime(p.Meta()); got != 45*time.Second {
+		t.Errorf("expected retry time to be %v, got %v", 45*time.Second, got)
+	}
+	if got := retryTimeLong(p.Meta()); got != 3*time.Minute {
+		t.Errorf("expected long retry time to be %v, got %v", 3*time.Minute, got)
+	}
+
+	for _, v := range []string{"soon", "-1m", "0s"} {
+		diags := Provider(IsNotMuxed).Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"token":                       "foo",
+			"skip_credentials_validation": true,
+			"retry_timeout":               v,
+		}))
+		if !diags.HasError() {
+			t.Errorf("expected an error for retry_timeout %q", v)
+		}
+	}
+}
+
+func TestProviderConfigureRequestTimeout(t *testing.T) {
+	p := Provider(IsNotMuxed)
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"token":                       "foo",
+		"skip_credentials_validation": true,
+		"request_timeout":             "20s",
+	}))
+	if len(diags) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if got := p.Meta().(*Config).requestTimeout(); got != 20*time.Second {
+		t.Errorf("expected request timeout to be %v, got %v", 20*time.Second, got)
+	}
+
+	diags = Provider(IsNotMuxed).Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"token":                       "foo",
+		"skip_credentials_validation": true,
+		"request_timeout":             "soon",
+	}))
+	if !diags.HasError() {
+		t.Errorf("expected an error for request_timeout %q", "soon")
+	}
+
+	diags = Provider(IsNotMuxed).Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"token":                       "foo",
+		"skip_credentials_validation": true,
+		"retry_timeout":               "30s",
+	}))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Summary != "`request_timeout` is not less than `retry_timeout`" {
+		t.Errorf("expected the request_timeout warning, got: %v", diags)
+	}
+}
+
+func TestProviderConfigureServiceRegionURLs(t *testing.T) {
+	cases := map[string][2]string{
+		"":   {"https://api.pagerduty.com", "https://app.pagerduty.com"},
+		"us": {"https://api.pagerduty.com", "https://app.pagerduty.com"},
+		"eu": {"https://api.eu.pagerduty.com", "https://app.eu.pagerduty.com"},
+	}
+	for region, urls := range cases {
+		p := Provider(IsNotMuxed)
+		diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"token":                       "foo",
+			"skip_credentials_validation": true,
+			"service_region":              region,
+		}))
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		config := p.Meta().(*Config)
+		if config.ApiUrl != urls[0] || config.AppUrl != urls[1] {
+			t.Errorf("region %q: expected %v, got [%s %s]", region, urls, config.ApiUrl, config.AppUrl)
+		}
+	}
+}
+
+func TestProviderConfigureInsecureTLSWarning(t *testing.T) {
+	config := map[string]interface{}{
+		"token":                       "foo",
+		"skip_credentials_validation": true,
+	}
+
+	diags := Provider(IsNotMuxed).Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if len(diags) > 0 {
+		t.Errorf("expected no diagnostics without insecure_tls, got: %v", diags)
+	}
+
+	config["insecure_tls"] = true
+	diags = Provider(IsNotMuxed).Configure(context.Background(), terraform.NewResourceConfigRaw(config))
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || diags[0].Detail != insecureTLSWarning {
+		t.Errorf("expected the insecure_tls warning, got: %v", diags)
+	}
+}
+
 func TestAccPagerDutyProviderAuthMethods_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -331,3 +448,35 @@ func testAccGetPagerDutyAccountDomain(t *testing.T) string {
 	}
 	return accountDomain
 }
+
+// newTestConfig sets `config` up to send its requests to a server answering
+// them with `handler`, which is closed when the test ends.
+func newTestConfig(t *testing.T, config *Config, handler http.HandlerFunc) *Config {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	config.Token = "foo"
+	config.ApiUrlOverride = srv.URL
+	config.AppUrl = srv.URL
+	config.SkipCredsValidation = true
+	return config
+}
+
+// twoPageListHandler serves a list of `collection` from `path` split in two
+// pages of one item each, `first` and `second`.
+func twoPageListHandler(path, collection, first, second string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == path && r.URL.Query().Get("offset") == "":
+			fmt.Fprintf(w, `{%q: [%s], "more": true, "limit": 1}`, collection, first)
+		case r.URL.Path == path && r.URL.Query().Get("offset") == "1":
+			fmt.Fprintf(w, `{%q: [%s], "more": false, "limit": 1}`, collection, second)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+		}
+	}
+}
@@ -48,7 +48,7 @@ func fetchPagerDutyAddon(d *schema.ResourceData, meta interface{}, errCallback f
 		return err
 	}
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		addon, _, err := client.Addons.Get(d.Id())
 		if err != nil {
 			log.Printf("[WARN] Service read error")
@@ -211,7 +211,7 @@ func resourcePagerDutyAutomationActionsActionCreate(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Creating PagerDuty AutomationActionsAction %s", automationActionsAction.Name)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if automationActionsAction, _, err := client.AutomationActionsAction.Create(automationActionsAction); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				time.Sleep(2 * time.Second)
@@ -260,7 +260,7 @@ func resourcePagerDutyAutomationActionsActionRead(d *schema.ResourceData, meta i
 
 	log.Printf("[INFO] Reading PagerDuty AutomationActionsAction %s", d.Id())
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if automationActionsAction, _, err := client.AutomationActionsAction.Get(d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -337,7 +337,7 @@ func resourcePagerDutyAutomationActionsActionDelete(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Deleting PagerDuty AutomationActionsAction %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.AutomationActionsAction.Delete(d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -44,7 +44,7 @@ func resourcePagerDutyAutomationActionsActionServiceAssociationCreate(d *schema.
 
 	log.Printf("[INFO] Creating PagerDuty AutomationActionsActionServiceAssociation %s:%s", d.Get("action_id").(string), d.Get("service_id").(string))
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if serviceRef, _, err := client.AutomationActionsAction.AssociateToService(actionID, serviceID); err != nil {
 			if isErrCode(err, 429) {
 				time.Sleep(2 * time.Second)
@@ -76,7 +76,7 @@ func fetchPagerDutyAutomationActionsActionServiceAssociation(d *schema.ResourceD
 		return err
 	}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := client.AutomationActionsAction.GetAssociationToService(actionID, serviceID)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -122,7 +122,7 @@ func resourcePagerDutyAutomationActionsActionServiceAssociationDelete(d *schema.
 
 	log.Printf("[INFO] Deleting PagerDuty AutomationActionsActionServiceAssociation %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.AutomationActionsAction.DissociateFromService(actionID, serviceID); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -44,7 +44,7 @@ func resourcePagerDutyAutomationActionsActionTeamAssociationCreate(d *schema.Res
 
 	log.Printf("[INFO] Creating PagerDuty AutomationActionsActionTeamAssociation %s:%s", d.Get("action_id").(string), d.Get("team_id").(string))
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if teamRef, _, err := client.AutomationActionsAction.AssociateToTeam(actionID, teamID); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -80,7 +80,7 @@ func fetchPagerDutyAutomationActionsActionTeamAssociation(d *schema.ResourceData
 		return err
 	}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := client.AutomationActionsAction.GetAssociationToTeam(actionID, teamID)
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -126,7 +126,7 @@ func resourcePagerDutyAutomationActionsActionTeamAssociationDelete(d *schema.Res
 
 	log.Printf("[INFO] Deleting PagerDuty AutomationActionsActionTeamAssociation %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.AutomationActionsAction.DissociateToTeam(actionID, teamID); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
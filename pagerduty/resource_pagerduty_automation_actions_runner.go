@@ -112,7 +112,7 @@ func resourcePagerDutyAutomationActionsRunnerCreate(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Creating PagerDuty AutomationActionsRunner %s", automationActionsRunner.Name)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if automationActionsRunner, _, err := client.AutomationActionsRunner.Create(automationActionsRunner); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				time.Sleep(2 * time.Second)
@@ -141,7 +141,7 @@ func resourcePagerDutyAutomationActionsRunnerRead(d *schema.ResourceData, meta i
 
 	log.Printf("[INFO] Reading PagerDuty AutomationActionsRunner %s", d.Id())
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if automationActionsRunner, _, err := client.AutomationActionsRunner.Get(d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -199,7 +199,7 @@ func resourcePagerDutyAutomationActionsRunnerDelete(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Deleting PagerDuty AutomationActionsRunner %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.AutomationActionsRunner.Delete(d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -100,7 +100,7 @@ func resourcePagerDutyBusinessServiceCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	retryErr := retry.Retry(5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		businessService, err := buildBusinessServiceStruct(d)
 		if err != nil {
 			return retry.NonRetryableError(err)
@@ -129,7 +129,7 @@ func resourcePagerDutyBusinessServiceRead(d *schema.ResourceData, meta interface
 
 	log.Printf("[INFO] Reading PagerDuty business service %s", d.Id())
 
-	retryErr := retry.Retry(5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		if businessService, _, err := client.BusinessServices.Get(d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -40,6 +40,10 @@ func resourcePagerDutyBusinessServiceSubscriber() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"subscriber_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -61,7 +65,7 @@ func resourcePagerDutyBusinessServiceSubscriberCreate(d *schema.ResourceData, me
 
 	businessServiceId := d.Get("business_service_id").(string)
 
-	retryErr := retry.Retry(5*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		businessServiceSubscriber, err := buildBusinessServiceSubscriberStruct(d)
 		if err != nil {
 			return retry.NonRetryableError(err)
@@ -96,7 +100,7 @@ func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta
 
 	log.Printf("[INFO] Reading PagerDuty business service %s subscriber %s type %s", businessServiceId, businessServiceSubscriber.ID, businessServiceSubscriber.Type)
 
-	return retry.Retry(5*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTimeLong(meta), func() *retry.RetryError {
 		if subscriberResponse, _, err := client.BusinessServiceSubscribers.List(businessServiceId); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -118,11 +122,49 @@ func resourcePagerDutyBusinessServiceSubscriberRead(d *schema.ResourceData, meta
 				d.SetId("")
 				return nil
 			}
+
+			// The name is informational only, failing to read it must not
+			// fail the refresh of the subscription.
+			name, err := fetchBusinessServiceSubscriberName(client, foundSubscriber)
+			if err != nil {
+				log.Printf("[WARN] Unable to read the name of business service subscriber %s %s: %s", foundSubscriber.Type, foundSubscriber.ID, err)
+			}
+			d.Set("subscriber_name", name)
 		}
 		return nil
 	})
 }
 
+// fetchBusinessServiceSubscriberName returns the name of the team or user
+// subscribed. An empty name is returned when the subscriber no longer exists
+// or isn't visible to the configured token.
+func fetchBusinessServiceSubscriberName(client *pagerduty.Client, subscriber *pagerduty.BusinessServiceSubscriber) (string, error) {
+	var name string
+	var err error
+
+	switch subscriber.Type {
+	case "team":
+		var team *pagerduty.Team
+		team, _, err = client.Teams.Get(subscriber.ID)
+		if team != nil {
+			name = team.Name
+		}
+	case "user":
+		var user *pagerduty.User
+		user, _, err = client.Users.Get(subscriber.ID, &pagerduty.GetUserOptions{})
+		if user != nil {
+			name = user.Name
+		}
+	}
+
+	if err != nil && (isErrCode(err, http.StatusNotFound) || isErrCode(err, http.StatusForbidden)) {
+		log.Printf("[WARN] Business service subscriber %s %s was not found", subscriber.Type, subscriber.ID)
+		return "", nil
+	}
+
+	return name, err
+}
+
 func resourcePagerDutyBusinessServiceSubscriberDelete(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -2,12 +2,14 @@ package pagerduty
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func TestAccPagerDutyBusinessServiceSubscriber_User(t *testing.T) {
@@ -27,6 +29,7 @@ func TestAccPagerDutyBusinessServiceSubscriber_User(t *testing.T) {
 					resource.TestCheckResourceAttr("pagerduty_business_service.foo", "name", businessServiceName),
 					resource.TestCheckResourceAttr("pagerduty_user.foo", "name", username),
 					resource.TestCheckResourceAttr("pagerduty_user.foo", "email", email),
+					resource.TestCheckResourceAttr("pagerduty_business_service_subscriber.foo", "subscriber_name", username),
 				),
 			},
 		},
@@ -47,6 +50,7 @@ func TestAccPagerDutyBusinessServiceSubscriber_Team(t *testing.T) {
 					testAccCheckPagerDutyBusinessServiceSubscriberExists("pagerduty_business_service_subscriber.foo"),
 					resource.TestCheckResourceAttr("pagerduty_business_service.foo", "name", businessServiceName),
 					resource.TestCheckResourceAttr("pagerduty_team.foo", "name", team),
+					resource.TestCheckResourceAttr("pagerduty_business_service_subscriber.foo", "subscriber_name", team),
 				),
 			},
 		},
@@ -195,3 +199,43 @@ func testAccCheckPagerDutyBusinessServiceSubscriberTeamUserConfig(businessServic
 	}
 `, businessServiceName, team, username, email)
 }
+
+func TestFetchBusinessServiceSubscriberName(t *testing.T) {
+	config := newTestConfig(t, &Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.URL.Path {
+		case "/teams/PTEAMID":
+			fmt.Fprint(w, `{"team": {"id": "PTEAMID", "name": "Foo"}}`)
+		case "/users/PFORBID":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error": {"code": 2010, "message": "Access Denied"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+		}
+	})
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+
+	cases := []struct {
+		subscriber *pagerduty.BusinessServiceSubscriber
+		want       string
+	}{
+		{subscriber: &pagerduty.BusinessServiceSubscriber{ID: "PTEAMID", Type: "team"}, want: "Foo"},
+		{subscriber: &pagerduty.BusinessServiceSubscriber{ID: "PGONE00", Type: "team"}, want: ""},
+		{subscriber: &pagerduty.BusinessServiceSubscriber{ID: "PFORBID", Type: "user"}, want: ""},
+	}
+
+	for _, c := range cases {
+		got, err := fetchBusinessServiceSubscriberName(client, c.subscriber)
+		if err != nil {
+			t.Errorf("unexpected error for %s %s: %v", c.subscriber.Type, c.subscriber.ID, err)
+		}
+		if got != c.want {
+			t.Errorf("expected the name of %s %s to be %q, got %q", c.subscriber.Type, c.subscriber.ID, c.want, got)
+		}
+	}
+}
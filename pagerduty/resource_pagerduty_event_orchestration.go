@@ -10,7 +10,9 @@ import (
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
-func resourcePagerDutyEventOrchestration() *schema.Resource {
+// resourcePagerDutyEventOrchestration takes the provider configuration getter
+// used to compare the team configured by name with the team id in state.
+func resourcePagerDutyEventOrchestration(meta func() interface{}) *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePagerDutyEventOrchestrationCreate,
 		Read:   resourcePagerDutyEventOrchestrationRead,
@@ -29,8 +31,9 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 				Optional: true,
 			},
 			"team": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressEventOrchestrationTeamDiff(meta),
 			},
 			"routes": {
 				Type:     schema.TypeInt,
@@ -73,7 +76,7 @@ func resourcePagerDutyEventOrchestration() *schema.Resource {
 	}
 }
 
-func buildEventOrchestrationStruct(d *schema.ResourceData) *pagerduty.EventOrchestration {
+func buildEventOrchestrationStruct(d *schema.ResourceData, meta interface{}) (*pagerduty.EventOrchestration, error) {
 	orchestration := &pagerduty.EventOrchestration{
 		Name: d.Get("name").(string),
 	}
@@ -83,8 +86,12 @@ func buildEventOrchestrationStruct(d *schema.ResourceData) *pagerduty.EventOrche
 	}
 
 	if attr, ok := d.GetOk("team"); ok {
+		teamID, err := resolveTeamID(meta, attr.(string))
+		if err != nil {
+			return nil, err
+		}
 		orchestration.Team = &pagerduty.EventOrchestrationObject{
-			ID: stringTypeToStringPtr(attr.(string)),
+			ID: stringTypeToStringPtr(teamID),
 		}
 	} else {
 		var tId *string
@@ -93,7 +100,7 @@ func buildEventOrchestrationStruct(d *schema.ResourceData) *pagerduty.EventOrche
 		}
 	}
 
-	return orchestration
+	return orchestration, nil
 }
 
 func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -102,12 +109,15 @@ func resourcePagerDutyEventOrchestrationCreate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	payload := buildEventOrchestrationStruct(d)
+	payload, err := buildEventOrchestrationStruct(d, meta)
+	if err != nil {
+		return err
+	}
 	var orchestration *pagerduty.EventOrchestration
 
 	log.Printf("[INFO] Creating PagerDuty Event Orchestration: %s", payload.Name)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if orch, _, err := client.EventOrchestrations.Create(payload); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return retry.RetryableError(err)
@@ -136,7 +146,7 @@ func resourcePagerDutyEventOrchestrationRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		orch, _, err := client.EventOrchestrations.Get(d.Id())
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -164,16 +174,19 @@ func resourcePagerDutyEventOrchestrationUpdate(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	orchestration := buildEventOrchestrationStruct(d)
+	orchestration, err := buildEventOrchestrationStruct(d, meta)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Updating PagerDuty Event Orchestration: %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, _, err := client.EventOrchestrations.Update(d.Id(), orchestration); err != nil {
-			if isErrCode(err, 400) || isErrCode(err, 429) {
-				return retry.RetryableError(err)
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
 			}
-			return retry.NonRetryableError(err)
+			return retry.RetryableError(err)
 		}
 
 		return nil
@@ -193,8 +206,21 @@ func resourcePagerDutyEventOrchestrationDelete(d *schema.ResourceData, meta inte
 	}
 
 	log.Printf("[INFO] Deleting PagerDuty Event Orchestration: %s", d.Id())
-	if _, err := client.EventOrchestrations.Delete(d.Id()); err != nil {
-		return err
+
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
+		if _, err := client.EventOrchestrations.Delete(d.Id()); err != nil {
+			if isErrCode(err, http.StatusNotFound) {
+				return nil
+			}
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
 	}
 
 	d.SetId("")
@@ -210,10 +236,27 @@ func flattenEventOrchestrationTeam(v *pagerduty.EventOrchestrationObject) []inte
 	return []interface{}{team}
 }
 
+// suppressEventOrchestrationTeamDiff hides the difference between the team id
+// in state and a team configured by a name that resolves to it. Team names are
+// cached by resolveTeamID, so each is only looked up once per provider.
+func suppressEventOrchestrationTeamDiff(meta func() interface{}) schema.SchemaDiffSuppressFunc {
+	return func(_, old, new string, _ *schema.ResourceData) bool {
+		if old == "" || new == "" || meta == nil || meta() == nil {
+			return false
+		}
+
+		id, err := resolveTeamID(meta(), new)
+		return err == nil && id == old
+	}
+}
+
 func flattenEventOrchestrationIntegrations(eoi []*pagerduty.EventOrchestrationIntegration) []interface{} {
 	var result []interface{}
 
 	for _, i := range eoi {
+		if i == nil {
+			continue
+		}
 		integration := map[string]interface{}{
 			"id":         i.ID,
 			"label":      i.Label,
@@ -50,6 +50,7 @@ func resourcePagerDutyEventOrchestrationGlobalCacheVariable() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"updated_by": eventOrchestrationCacheVariableUpdatedBySchema,
 		},
 	}
 }
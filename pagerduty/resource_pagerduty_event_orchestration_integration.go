@@ -97,7 +97,7 @@ func resourcePagerDutyEventOrchestrationIntegrationCreate(ctx context.Context, d
 
 	oid, payload := getEventOrchestrationIntegrationPayloadData(d)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Creating Integration '%s' for PagerDuty Event Orchestration '%s'", payload.Label, oid)
 
 		if integration, _, err := client.EventOrchestrationIntegrations.CreateContext(ctx, oid, payload); err != nil {
@@ -127,7 +127,7 @@ func resourcePagerDutyEventOrchestrationIntegrationRead(ctx context.Context, d *
 	id := d.Id()
 	oid := d.Get("event_orchestration").(string)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Integration '%s' for PagerDuty Event Orchestration: %s", id, oid)
 
 		if _, err := fetchPagerDutyEventOrchestrationIntegration(ctx, d, meta, oid, id, false); err != nil {
@@ -162,7 +162,7 @@ func resourcePagerDutyEventOrchestrationIntegrationUpdate(ctx context.Context, d
 		sourceOrchId := o.(string)
 		destinationOrchId := n.(string)
 
-		retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 			log.Printf("[INFO] Migrating Event Orchestration Integration '%s': source - '%s', destination - '%s'", id, sourceOrchId, destinationOrchId)
 
 			if _, _, err := client.EventOrchestrationIntegrations.MigrateFromOrchestrationContext(ctx, destinationOrchId, sourceOrchId, id); err != nil {
@@ -200,7 +200,7 @@ func resourcePagerDutyEventOrchestrationIntegrationUpdate(ctx context.Context, d
 	if d.HasChange("label") {
 		oid, payload := getEventOrchestrationIntegrationPayloadData(d)
 
-		retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 			log.Printf("[INFO] Updating Integration '%s' for PagerDuty Event Orchestration: %s", id, oid)
 
 			if integration, _, err := client.EventOrchestrationIntegrations.UpdateContext(ctx, oid, id, payload); err != nil {
@@ -236,7 +236,7 @@ func resourcePagerDutyEventOrchestrationIntegrationDelete(ctx context.Context, d
 	id := d.Id()
 	oid, _ := getEventOrchestrationIntegrationPayloadData(d)
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Deleting Integration '%s' for PagerDuty Event Orchestration: %s", id, oid)
 		if _, err := client.EventOrchestrationIntegrations.DeleteContext(ctx, oid, id); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -274,7 +274,7 @@ func resourcePagerDutyEventOrchestrationIntegrationImport(ctx context.Context, d
 		return []*schema.ResourceData{}, fmt.Errorf("Error importing pagerduty_event_orchestration_integration. Expected import ID format: <orchestration_id>:<integration_id>")
 	}
 
-	retryErr := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		log.Printf("[INFO] Reading Integration '%s' for PagerDuty Event Orchestration: %s", id, oid)
 
 		if _, err := fetchPagerDutyEventOrchestrationIntegration(ctx, d, meta, oid, id, false); err != nil {
@@ -294,7 +294,13 @@ func resourcePagerDutyEventOrchestrationIntegrationImport(ctx context.Context, d
 	return []*schema.ResourceData{d}, nil
 }
 
+// flattenEventOrchestrationIntegrationParameters flattens the routing key and
+// its type. The API currently only returns `global` as the routing key type.
 func flattenEventOrchestrationIntegrationParameters(p *pagerduty.EventOrchestrationIntegrationParameters) []interface{} {
+	if p == nil {
+		return []interface{}{}
+	}
+
 	result := map[string]interface{}{
 		"routing_key": p.RoutingKey,
 		"type":        p.Type,
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
 func init() {
@@ -172,3 +174,39 @@ func testAccCheckPagerDutyEventOrchestrationIntegrationDeletedConfig(onp string)
 		}
 	`, onp, onp)
 }
+
+func TestFlattenEventOrchestrationIntegrationParameters(t *testing.T) {
+	if got := flattenEventOrchestrationIntegrationParameters(nil); len(got) != 0 {
+		t.Errorf("expected no parameters for a nil pointer, got %v", got)
+	}
+
+	p := &pagerduty.EventOrchestrationIntegrationParameters{RoutingKey: "R0UT1NGK3Y", Type: "global"}
+	got := flattenEventOrchestrationIntegrationParameters(p)
+	if len(got) != 1 {
+		t.Fatalf("expected exactly one parameters block, got %v", got)
+	}
+	m := got[0].(map[string]interface{})
+	if m["routing_key"] != "R0UT1NGK3Y" || m["type"] != "global" {
+		t.Errorf("unexpected parameters block: %v", m)
+	}
+}
+
+func TestSetEventOrchestrationIntegrationProps_NilParameters(t *testing.T) {
+	i := &pagerduty.EventOrchestrationIntegration{ID: "P1NT3GR", Label: "no parameters"}
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyEventOrchestrationIntegration().Schema, map[string]interface{}{})
+	if err := setEventOrchestrationIntegrationProps(d, i); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := d.Get("parameters").([]interface{}); len(got) != 0 {
+		t.Errorf("expected empty parameters, got %v", got)
+	}
+
+	integrations := flattenEventOrchestrationIntegrations([]*pagerduty.EventOrchestrationIntegration{i})
+	if len(integrations) != 1 {
+		t.Fatalf("expected one integration, got %v", integrations)
+	}
+	if got := integrations[0].(map[string]interface{})["parameters"].([]interface{}); len(got) != 0 {
+		t.Errorf("expected empty parameters, got %v", got)
+	}
+}
@@ -3,6 +3,7 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -143,6 +144,21 @@ func TestAccPagerDutyEventOrchestrationPathRouter_EnableRoutingRule(t *testing.T
 	})
 }
 
+func TestAccPagerDutyEventOrchestrationPathRouter_MalformedCondition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccCheckPagerDutyEventOrchestrationRouterConfigMalformedCondition(),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+				ExpectError:        regexp.MustCompile("is not a valid condition expression: 1 unclosed parenthesis"),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyEventOrchestrationRouterDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -192,6 +208,38 @@ func testAccCheckPagerDutyEventOrchestrationRouterNotExists(rn string) resource.
 	}
 }
 
+func TestAccPagerDutyEventOrchestrationPathRouter_RoutesCount(t *testing.T) {
+	team := fmt.Sprintf("tf-name-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	orchestration := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationRouterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterConfigNoRules(team, escalationPolicy, service, orchestration),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration.orch", "routes", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationRouterConfig(team, escalationPolicy, service, orchestration),
+			},
+			// The router rules are written after the orchestration was read,
+			// so the new count is only seen once the orchestration is refreshed.
+			{
+				RefreshState: true,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("pagerduty_event_orchestration.orch", "routes", "1"),
+				),
+			},
+		},
+	})
+}
+
 func createBaseConfig(t, ep, s, o string) string {
 	return fmt.Sprintf(`
 	resource "pagerduty_team" "foo" {
@@ -306,6 +354,31 @@ func testAccCheckPagerDutyEventOrchestrationRouterConfigWithConditions(t, ep, s,
 	`)
 }
 
+func testAccCheckPagerDutyEventOrchestrationRouterConfigMalformedCondition() string {
+	return `
+resource "pagerduty_event_orchestration_router" "router" {
+	event_orchestration = "PXXXXXX"
+
+	catch_all {
+		actions {
+			route_to = "unrouted"
+		}
+	}
+	set {
+		id = "start"
+		rule {
+			actions {
+				route_to = "PXXXXXX"
+			}
+			condition {
+				expression = "(event.summary matches part 'database'"
+			}
+		}
+	}
+}
+`
+}
+
 func testAccCheckPagerDutyEventOrchestrationRouterConfigWithMultipleRules(t, ep, s, o string) string {
 	return fmt.Sprintf(
 		"%s%s", createBaseConfig(t, ep, s, o),
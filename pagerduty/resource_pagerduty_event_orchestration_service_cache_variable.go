@@ -50,6 +50,7 @@ func resourcePagerDutyEventOrchestrationServiceCacheVariable() *schema.Resource
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"updated_by": eventOrchestrationCacheVariableUpdatedBySchema,
 		},
 	}
 }
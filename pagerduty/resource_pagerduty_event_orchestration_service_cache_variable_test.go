@@ -68,6 +68,7 @@ func TestAccPagerDutyEventOrchestrationServiceCacheVariable_Basic(t *testing.T)
 					testAccCheckPagerDutyEventOrchestrationServiceCacheVariableID(cv, svcn1),
 					resource.TestCheckResourceAttr(cv, "name", name2),
 					resource.TestCheckResourceAttr(cv, "disabled", disabled2),
+					resource.TestCheckResourceAttrSet(cv, "updated_by.0.id"),
 				),
 			},
 			// update config:
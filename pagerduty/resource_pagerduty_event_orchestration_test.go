@@ -3,8 +3,11 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -126,6 +129,194 @@ func TestAccPagerDutyEventOrchestration_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyEventOrchestration_TeamByName(t *testing.T) {
+	name := fmt.Sprintf("tf-orchestration-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-team-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyEventOrchestrationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyEventOrchestrationConfigTeamByName(name, team),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyEventOrchestrationExists("pagerduty_event_orchestration.foo"),
+					testAccCheckPagerDutyEventOrchestrationTeamMatch("pagerduty_event_orchestration.foo", "pagerduty_team.foo"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyEventOrchestrationConfigTeamByName(name, team),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// Test the team is read as its id without looking up teams, and a team
+// configured by name is only compared with it once per provider
+func TestResourcePagerDutyEventOrchestrationTeamByName(t *testing.T) {
+	var teamRequests int32
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/event_orchestrations/PORCH01":
+			fmt.Fprint(w, `{"orchestration": {"id": "PORCH01", "name": "foo", "team": {"id": "PTEAM01"}}}`)
+		case "/teams":
+			atomic.AddInt32(&teamRequests, 1)
+			fmt.Fprint(w, `{"teams": [{"id": "PTEAM01", "name": "Foo"}, {"id": "PTEAM02", "name": "Bar"}], "more": false}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+		}
+	})
+
+	d := resourcePagerDutyEventOrchestration(nil).Data(nil)
+	d.SetId("PORCH01")
+	d.Set("team", "Foo")
+
+	if err := resourcePagerDutyEventOrchestrationRead(d, config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := d.Get("team").(string); got != "PTEAM01" {
+		t.Errorf("expected team to be read as %q, got %q", "PTEAM01", got)
+	}
+	if got := atomic.LoadInt32(&teamRequests); got != 0 {
+		t.Errorf("expected no requests for teams on read, got %d", got)
+	}
+
+	suppress := suppressEventOrchestrationTeamDiff(func() interface{} { return config })
+	cases := []struct {
+		old, new     string
+		want         bool
+		teamRequests int32
+	}{
+		{old: "", new: "Foo", want: false, teamRequests: 0},
+		{old: "PTEAM01", new: "", want: false, teamRequests: 0},
+		{old: "PTEAM01", new: "Foo", want: true, teamRequests: 1},
+		{old: "PTEAM01", new: "Foo", want: true, teamRequests: 1},
+		{old: "PTEAM01", new: "Bar", want: false, teamRequests: 2},
+	}
+
+	for _, c := range cases {
+		if got := suppress("team", c.old, c.new, nil); got != c.want {
+			t.Errorf("expected %q -> %q suppressed to be %v, got %v", c.old, c.new, c.want, got)
+		}
+		if got := atomic.LoadInt32(&teamRequests); got != c.teamRequests {
+			t.Errorf("expected %d requests for teams after comparing %q, got %d", c.teamRequests, c.new, got)
+		}
+	}
+}
+
+func TestResourcePagerDutyEventOrchestrationReadRoutes(t *testing.T) {
+	routes := 0
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"orchestration": {"id": "PORCH01", "name": "foo", "routes": %d}}`, routes)
+	})
+
+	d := resourcePagerDutyEventOrchestration(nil).Data(nil)
+	d.SetId("PORCH01")
+
+	for _, want := range []int{0, 2, 1} {
+		routes = want
+		if err := resourcePagerDutyEventOrchestrationRead(d, config); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := d.Get("routes").(int); got != want {
+			t.Errorf("expected routes to be %d, got %d", want, got)
+		}
+	}
+}
+
+// Test a rate limited update is retried
+func TestResourcePagerDutyEventOrchestrationUpdateRateLimited(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{RetryTime: 30 * time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("ratelimit-reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error": {"code": 2020, "message": "Rate Limit Exceeded"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"orchestration": {"id": "PORCH01", "name": "foo"}}`)
+	})
+
+	d := resourcePagerDutyEventOrchestration(nil).Data(nil)
+	d.SetId("PORCH01")
+	d.Set("name", "foo")
+
+	if err := resourcePagerDutyEventOrchestrationUpdate(d, config); err != nil {
+		t.Fatalf("expected the update to succeed on retry, got: %v", err)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+// Test a bad request on update fails without retrying
+func TestResourcePagerDutyEventOrchestrationUpdateBadRequest(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{RetryTime: 30 * time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`)
+	})
+
+	d := resourcePagerDutyEventOrchestration(nil).Data(nil)
+	d.SetId("PORCH01")
+	d.Set("name", "foo")
+
+	if err := resourcePagerDutyEventOrchestrationUpdate(d, config); err == nil {
+		t.Fatalf("expected the update to fail")
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("expected a single request, got %d", got)
+	}
+}
+
+// Test deleting an event orchestration already gone succeeds, and a bad
+// request fails without retrying
+func TestResourcePagerDutyEventOrchestrationDelete(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{name: "not found", status: http.StatusNotFound, body: `{"error": {"code": 2100, "message": "Not Found"}}`},
+		{name: "bad request", status: http.StatusBadRequest, body: `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var requests int32
+			config := newTestConfig(t, &Config{RetryTime: 30 * time.Second}, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, c.body)
+			})
+
+			d := resourcePagerDutyEventOrchestration(nil).Data(nil)
+			d.SetId("PORCH01")
+
+			err := resourcePagerDutyEventOrchestrationDelete(d, config)
+			if (err != nil) != c.wantErr {
+				t.Errorf("expected error to be %v, got: %v", c.wantErr, err)
+			}
+			if !c.wantErr && d.Id() != "" {
+				t.Errorf("expected the id to be cleared, got %q", d.Id())
+			}
+			if got := atomic.LoadInt32(&requests); got != 1 {
+				t.Errorf("expected a single request, got %d", got)
+			}
+		})
+	}
+}
+
 func testAccCheckPagerDutyEventOrchestrationDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -243,3 +434,16 @@ resource "pagerduty_event_orchestration" "foo" {
 }
 `, team1, team2, name)
 }
+
+func testAccCheckPagerDutyEventOrchestrationConfigTeamByName(name, team string) string {
+	return fmt.Sprintf(`
+
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+resource "pagerduty_event_orchestration" "foo" {
+	name = "%s"
+	team = pagerduty_team.foo.name
+}
+`, team, name)
+}
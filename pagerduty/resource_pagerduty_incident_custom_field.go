@@ -2,10 +2,14 @@ package pagerduty
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -18,8 +22,9 @@ func resourcePagerDutyIncidentCustomField() *schema.Resource {
 		UpdateContext: resourcePagerDutyIncidentCustomFieldUpdate,
 		DeleteContext: resourcePagerDutyIncidentCustomFieldDelete,
 		CreateContext: resourcePagerDutyIncidentCustomFieldCreate,
+		CustomizeDiff: validateIncidentCustomFieldDefaultValue,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePagerDutyIncidentCustomFieldImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -45,8 +50,9 @@ func resourcePagerDutyIncidentCustomField() *schema.Resource {
 				ValidateDiagFunc: validateIncidentCustomFieldFieldType(),
 			},
 			"default_value": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressIncidentCustomFieldDefaultValueDiff,
 			},
 		},
 	}
@@ -63,6 +69,10 @@ func resourcePagerDutyIncidentCustomFieldCreate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	if diags := validateIncidentCustomFieldDefaultValueOptions(ctx, client, "", field); diags.HasError() {
+		return diags
+	}
+
 	log.Printf("[INFO] Creating PagerDuty incident custom field %s", field.Name)
 
 	createdField, _, err := client.IncidentCustomFields.CreateContext(ctx, field)
@@ -74,7 +84,7 @@ func resourcePagerDutyIncidentCustomFieldCreate(ctx context.Context, d *schema.R
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return incidentCustomFieldMissingOptionsDiagnostics(ctx, client, createdField)
 }
 
 func resourcePagerDutyIncidentCustomFieldDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -101,6 +111,16 @@ func resourcePagerDutyIncidentCustomFieldUpdate(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
+	if diags := validateIncidentCustomFieldDefaultValueOptions(ctx, client, d.Id(), field); diags.HasError() {
+		return diags
+	}
+
+	// The client omits a nil default value from the payload, so removing it
+	// from the configuration has to be sent as an explicit null to be cleared.
+	if d.HasChange("default_value") && field.DefaultValue == nil {
+		field.DefaultValue = json.RawMessage("null")
+	}
+
 	log.Printf("[INFO] Updating PagerDuty incident custom field %s", d.Id())
 
 	updatedField, _, err := client.IncidentCustomFields.UpdateContext(ctx, d.Id(), field)
@@ -112,7 +132,7 @@ func resourcePagerDutyIncidentCustomFieldUpdate(ctx context.Context, d *schema.R
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return incidentCustomFieldMissingOptionsDiagnostics(ctx, client, updatedField)
 }
 
 func resourcePagerDutyIncidentCustomFieldRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -130,7 +150,7 @@ func fetchField(ctx context.Context, d *schema.ResourceData, meta interface{}, e
 		return err
 	}
 
-	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	return retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		field, _, err := client.IncidentCustomFields.GetContext(ctx, d.Id(), nil)
 		if err != nil {
 			log.Printf("[WARN] Incident custom field read error")
@@ -154,6 +174,136 @@ func fetchField(ctx context.Context, d *schema.ResourceData, meta interface{}, e
 	})
 }
 
+// incidentCustomFieldMissingOptionsDiagnostics warns about fixed fields
+// without any options, since no value could ever be selected for them. It is
+// only checked once a field is created or updated, so refreshing the field
+// doesn't repeat the warning on every plan.
+func incidentCustomFieldMissingOptionsDiagnostics(ctx context.Context, client *pagerduty.Client, field *pagerduty.IncidentCustomField) diag.Diagnostics {
+	if field == nil || !isIncidentCustomFieldFixed(field.FieldType) {
+		return nil
+	}
+	// The field is already saved, so failing to list its options mustn't fail
+	// the apply over a warning
+	l, _, err := client.IncidentCustomFields.ListFieldOptionsContext(ctx, field.ID)
+	if err != nil {
+		log.Printf("[WARN] Listing options of incident custom field %s: %v", field.ID, err)
+		return nil
+	}
+	if len(l.FieldOptions) > 0 {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Incident custom field %s of type %s has no field options", field.ID, field.FieldType.String()),
+			Detail:   "Values of fixed incident custom fields can only be selected from their options. Add at least one pagerduty_incident_custom_field_option resource for this field.",
+		},
+	}
+}
+
+// validateIncidentCustomFieldDefaultValueOptions rejects the default value of
+// a fixed field that isn't the value of one of its options, which PagerDuty
+// would reject without telling which value is wrong. Options are separate
+// resources, so they are listed from PagerDuty for the field `fieldID`, empty
+// when the field is yet to be created.
+func validateIncidentCustomFieldDefaultValueOptions(ctx context.Context, client *pagerduty.Client, fieldID string, field *pagerduty.IncidentCustomField) diag.Diagnostics {
+	if !isIncidentCustomFieldFixed(field.FieldType) || field.DefaultValue == nil {
+		return nil
+	}
+
+	values := make(map[string]bool)
+	var valueList []string
+	if fieldID != "" {
+		l, _, err := client.IncidentCustomFields.ListFieldOptionsContext(ctx, fieldID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, o := range l.FieldOptions {
+			if o.Data == nil {
+				continue
+			}
+			v, err := flattenIncidentCustomFieldValue(o.Data.Value, o.Data.DataType, false)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			values[v] = true
+			valueList = append(valueList, v)
+		}
+	}
+
+	defaultValues := []interface{}{field.DefaultValue}
+	if field.FieldType.IsMultiValue() {
+		defaultValues, _ = field.DefaultValue.([]interface{})
+	}
+
+	var diags diag.Diagnostics
+	for _, dv := range defaultValues {
+		v, err := flattenIncidentCustomFieldValue(dv, field.DataType, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if values[v] {
+			continue
+		}
+
+		detail := fmt.Sprintf("%q is not the value of any option of the field. Valid values are: %s.", v, strings.Join(valueList, ", "))
+		if len(values) == 0 {
+			detail = fmt.Sprintf("%q is not the value of any option of the field, as it has none yet. Set default_value once the field's pagerduty_incident_custom_field_option resources exist.", v)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("Invalid default_value for %s field %s", field.FieldType.String(), field.Name),
+			Detail:        detail,
+			AttributePath: cty.GetAttrPath("default_value"),
+		})
+	}
+	return diags
+}
+
+func isIncidentCustomFieldFixed(t pagerduty.IncidentCustomFieldFieldType) bool {
+	return t == pagerduty.IncidentCustomFieldFieldTypeSingleValueFixed || t == pagerduty.IncidentCustomFieldFieldTypeMultiValueFixed
+}
+
+// resourcePagerDutyIncidentCustomFieldImport accepts either the id or the
+// name of the field, since the name is what users usually know.
+func resourcePagerDutyIncidentCustomFieldImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client, err := meta.(*Config).Client()
+	if err != nil {
+		return nil, err
+	}
+
+	idOrName := d.Id()
+	var found *pagerduty.IncidentCustomField
+	err = retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
+		resp, _, err := client.IncidentCustomFields.ListContext(ctx, nil)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			time.Sleep(2 * time.Second)
+			return retry.RetryableError(err)
+		}
+
+		for _, field := range resp.Fields {
+			if field.ID == idOrName || field.Name == idOrName {
+				found = field
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("Unable to locate any incident custom field with id or name: %s", idOrName)
+	}
+
+	d.SetId(found.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func flattenIncidentCustomField(d *schema.ResourceData, field *pagerduty.IncidentCustomField) error {
 	d.SetId(field.ID)
 	d.Set("name", field.Name)
@@ -165,11 +315,13 @@ func flattenIncidentCustomField(d *schema.ResourceData, field *pagerduty.Inciden
 	d.Set("field_type", field.FieldType.String())
 
 	if field.DefaultValue != nil {
-		v, err := convertIncidentCustomFieldValueForFlatten(field.DefaultValue, field.FieldType.IsMultiValue())
+		v, err := flattenIncidentCustomFieldValue(field.DefaultValue, field.DataType, field.FieldType.IsMultiValue())
 		if err != nil {
 			return err
 		}
 		d.Set("default_value", v)
+	} else {
+		d.Set("default_value", nil)
 	}
 	return nil
 }
@@ -186,7 +338,102 @@ func buildFieldStruct(d *schema.ResourceData) (*pagerduty.IncidentCustomField, e
 		field.Description = &str
 	}
 	if df, ok := d.GetOk("default_value"); ok {
-		field.DefaultValue = df
+		v, err := convertIncidentCustomFieldValueForBuild(df.(string), field.DataType, field.FieldType.IsMultiValue())
+		if err != nil {
+			return nil, fmt.Errorf("invalid default_value for data_type %v: %v", field.DataType, df)
+		}
+		field.DefaultValue = v
 	}
 	return &field, nil
 }
+
+func validateIncidentCustomFieldDefaultValue(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	value := diff.Get("default_value").(string)
+	if value == "" || !diff.NewValueKnown("default_value") || !diff.NewValueKnown("data_type") || !diff.NewValueKnown("field_type") {
+		return nil
+	}
+
+	datatype := pagerduty.IncidentCustomFieldDataTypeFromString(diff.Get("data_type").(string))
+	fieldtype := pagerduty.IncidentCustomFieldFieldTypeFromString(diff.Get("field_type").(string))
+	if !datatype.IsKnown() || !fieldtype.IsKnown() {
+		return nil
+	}
+
+	generateError := func() error {
+		if !fieldtype.IsMultiValue() {
+			switch datatype {
+			case pagerduty.IncidentCustomFieldDataTypeInt:
+				return fmt.Errorf("invalid default_value for data_type %v: %q is not a whole number", datatype, value)
+			case pagerduty.IncidentCustomFieldDataTypeFloat:
+				return fmt.Errorf("invalid default_value for data_type %v: %q is not a number", datatype, value)
+			case pagerduty.IncidentCustomFieldDataTypeBool:
+				return fmt.Errorf("invalid default_value for data_type %v: %q is not true or false", datatype, value)
+			}
+		}
+		return fmt.Errorf("invalid default_value for data_type %v: %v", datatype, value)
+	}
+
+	return validateIncidentCustomFieldValue(value, datatype, fieldtype.IsMultiValue(), generateError)
+}
+
+// suppressIncidentCustomFieldDefaultValueDiff ignores the difference between
+// default values PagerDuty takes as the same, e.g. datetimes denoting the
+// same instants or numbers written differently, as PagerDuty may not send them
+// back formatted as in the configuration.
+func suppressIncidentCustomFieldDefaultValueDiff(_, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+
+	datatype := pagerduty.IncidentCustomFieldDataTypeFromString(d.Get("data_type").(string))
+	fieldtype := pagerduty.IncidentCustomFieldFieldTypeFromString(d.Get("field_type").(string))
+	if !fieldtype.IsMultiValue() {
+		return isSameIncidentCustomFieldValue(datatype, old, new)
+	}
+
+	if datatype != pagerduty.IncidentCustomFieldDataTypeDateTime {
+		return false
+	}
+	var oldValues, newValues []interface{}
+	if json.Unmarshal([]byte(old), &oldValues) != nil || json.Unmarshal([]byte(new), &newValues) != nil {
+		return false
+	}
+	if len(oldValues) != len(newValues) {
+		return false
+	}
+	for i := range oldValues {
+		o, ok := oldValues[i].(string)
+		if !ok {
+			return false
+		}
+		n, ok := newValues[i].(string)
+		if !ok {
+			return false
+		}
+		if !isSameIncidentCustomFieldValue(datatype, o, n) {
+			return false
+		}
+	}
+	return true
+}
+
+func isSameIncidentCustomFieldValue(datatype pagerduty.IncidentCustomFieldDataType, old, new string) bool {
+	switch datatype {
+	case pagerduty.IncidentCustomFieldDataTypeDateTime:
+		ot, err := time.Parse(time.RFC3339, old)
+		if err != nil {
+			return false
+		}
+		nt, err := time.Parse(time.RFC3339, new)
+		return err == nil && ot.Equal(nt)
+	case pagerduty.IncidentCustomFieldDataTypeInt, pagerduty.IncidentCustomFieldDataTypeFloat, pagerduty.IncidentCustomFieldDataTypeBool:
+		o, err := convertIncidentCustomFieldValueForBuild(old, datatype, false)
+		if err != nil {
+			return false
+		}
+		n, err := convertIncidentCustomFieldValueForBuild(new, datatype, false)
+		return err == nil && o == n
+	default:
+		return old == new
+	}
+}
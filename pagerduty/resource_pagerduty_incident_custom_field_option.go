@@ -28,13 +28,18 @@ func resourcePagerDutyIncidentCustomFieldOption() *schema.Resource {
 				Required: true,
 			},
 			"data_type": {
-				Type:             schema.TypeString,
-				Required:         true,
-				ValidateDiagFunc: validateValueDiagFunc([]string{pagerduty.IncidentCustomFieldDataTypeString.String()}),
-			},
-			"value": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					pagerduty.IncidentCustomFieldDataTypeString.String(),
+					pagerduty.IncidentCustomFieldDataTypeInt.String(),
+					pagerduty.IncidentCustomFieldDataTypeFloat.String(),
+				}),
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressIncidentCustomFieldOptionValueDiff,
 			},
 		},
 	}
@@ -51,6 +56,16 @@ func validateIncidentCustomFieldOptionValue(_ context.Context, diff *schema.Reso
 	return validateIncidentCustomFieldValue(value, datatype, false, generateError)
 }
 
+// suppressIncidentCustomFieldOptionValueDiff ignores numbers written
+// differently than PagerDuty sends them back, e.g. "3.10" for "3.1".
+func suppressIncidentCustomFieldOptionValueDiff(_, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	datatype := pagerduty.IncidentCustomFieldDataTypeFromString(d.Get("data_type").(string))
+	return isSameIncidentCustomFieldValue(datatype, old, new)
+}
+
 func resourcePagerDutyIncidentCustomFieldOptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -64,14 +79,22 @@ func resourcePagerDutyIncidentCustomFieldOptionCreate(ctx context.Context, d *sc
 
 	log.Printf("[INFO] Creating PagerDuty incident custom field option %s: %s", fieldID, fieldOption.Data.Value)
 
-	createdFieldOption, _, err := client.IncidentCustomFields.CreateFieldOptionContext(ctx, fieldID, fieldOption)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
+		createdFieldOption, _, err := client.IncidentCustomFields.CreateFieldOptionContext(ctx, fieldID, fieldOption)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
 
-	err = flattenFieldOption(d, fieldID, createdFieldOption)
-	if err != nil {
-		return diag.FromErr(err)
+		if err := flattenFieldOption(d, fieldID, createdFieldOption); err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
 	}
 	return nil
 }
@@ -83,10 +106,25 @@ func resourcePagerDutyIncidentCustomFieldOptionDelete(ctx context.Context, d *sc
 		return diag.FromErr(err)
 	}
 
-	_, err = client.IncidentCustomFields.DeleteFieldOptionContext(ctx, fieldID, d.Id())
-	if err != nil {
-		return diag.FromErr(err)
+	log.Printf("[INFO] Deleting PagerDuty incident custom field option %s:%s", fieldID, d.Id())
+
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
+		if _, err := client.IncidentCustomFields.DeleteFieldOptionContext(ctx, fieldID, d.Id()); err != nil {
+			if isErrCode(err, http.StatusNotFound) {
+				return nil
+			}
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
 	}
+
+	d.SetId("")
 	return nil
 }
 
@@ -103,14 +141,22 @@ func resourcePagerDutyIncidentCustomFieldOptionUpdate(ctx context.Context, d *sc
 
 	log.Printf("[INFO] Updating PagerDuty incident custom field Option %s:%s", fieldID, d.Id())
 
-	updatedFieldOption, _, err := client.IncidentCustomFields.UpdateFieldOptionContext(ctx, fieldID, d.Id(), fieldOption)
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	retryErr := retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
+		updatedFieldOption, _, err := client.IncidentCustomFields.UpdateFieldOptionContext(ctx, fieldID, d.Id(), fieldOption)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
 
-	err = flattenFieldOption(d, fieldID, updatedFieldOption)
-	if err != nil {
-		return diag.FromErr(err)
+		if err := flattenFieldOption(d, fieldID, updatedFieldOption); err != nil {
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+	if retryErr != nil {
+		return diag.FromErr(retryErr)
 	}
 	return nil
 }
@@ -126,7 +172,7 @@ func resourcePagerDutyIncidentCustomFieldOptionRead(ctx context.Context, d *sche
 }
 
 func flattenFieldOption(d *schema.ResourceData, fieldID string, fieldOption *pagerduty.IncidentCustomFieldOption) error {
-	value, err := convertIncidentCustomFieldValueForFlatten(fieldOption.Data.Value, false)
+	value, err := flattenIncidentCustomFieldValue(fieldOption.Data.Value, fieldOption.Data.DataType, false)
 	if err != nil {
 		return err
 	}
@@ -163,7 +209,7 @@ func fetchFieldOption(ctx context.Context, fieldID string, d *schema.ResourceDat
 		return err
 	}
 
-	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	return retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		fieldOption, _, err := client.IncidentCustomFields.GetFieldOptionContext(ctx, fieldID, d.Id())
 		if err != nil {
 			log.Printf("[WARN] Field option read error")
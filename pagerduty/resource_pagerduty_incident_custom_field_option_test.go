@@ -2,11 +2,18 @@ package pagerduty
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -22,13 +29,169 @@ func TestAccPagerDutyIncidentCustomFieldOptions_Basic(t *testing.T) {
 	testAccExecuteIncidentCustomFieldOptionTest(t, fieldName, dataType, fieldOptionValue, fieldOptionValue2)
 }
 
-func TestAccPagerDutyIncidentCustomFieldOptions_InvalidDataType(t *testing.T) {
+func TestAccPagerDutyIncidentCustomFieldOptions_Integer(t *testing.T) {
 	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
-	fieldOptionValue := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	fieldOptionValue := strconv.Itoa(acctest.RandIntRange(1, 1000))
+	fieldOptionValue2 := strconv.Itoa(acctest.RandIntRange(1000, 2000))
 	dataType := pagerduty.IncidentCustomFieldDataTypeInt
 
+	testAccExecuteIncidentCustomFieldOptionTest(t, fieldName, dataType, fieldOptionValue, fieldOptionValue2)
+}
+
+func TestAccPagerDutyIncidentCustomFieldOptions_Float(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	fieldOptionValue := fmt.Sprintf("%d.5", acctest.RandIntRange(1, 1000))
+	fieldOptionValue2 := fmt.Sprintf("%d.25", acctest.RandIntRange(1000, 2000))
+	dataType := pagerduty.IncidentCustomFieldDataTypeFloat
+
+	testAccExecuteIncidentCustomFieldOptionTest(t, fieldName, dataType, fieldOptionValue, fieldOptionValue2)
+}
+
+func TestAccPagerDutyIncidentCustomFieldOptions_InvalidDataType(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+	fieldOptionValue := "true"
+	dataType := pagerduty.IncidentCustomFieldDataTypeBool
+
 	testAccExecuteIncidentCustomFieldOptionTestError(t, fieldName, dataType, fieldOptionValue,
-		regexp.MustCompile(`Error: "integer" is an invalid value. Must be one of \[]string{"string"}`))
+		regexp.MustCompile(`Error: "boolean" is an invalid value. Must be one of`))
+}
+
+// Test numeric option values are sent as numbers and read back without a diff
+func TestResourcePagerDutyIncidentCustomFieldOptionNumericValue(t *testing.T) {
+	r := resourcePagerDutyIncidentCustomFieldOption()
+
+	cases := []struct {
+		dataType, value, sentBack string
+		want                      interface{}
+	}{
+		{"integer", "5", "5", int64(5)},
+		{"float", "3.10", "3.1", 3.1},
+		{"float", "1000000", "1e6", float64(1000000)},
+	}
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"field":     "PCF0001",
+			"data_type": c.dataType,
+			"value":     c.value,
+		})
+		_, fieldOption, err := buildFieldOptionStruct(d)
+		if err != nil {
+			t.Fatalf("%s %q: unexpected error: %v", c.dataType, c.value, err)
+		}
+		if fieldOption.Data.Value != c.want {
+			t.Errorf("%s %q: expected %#v to be sent, got %#v", c.dataType, c.value, c.want, fieldOption.Data.Value)
+		}
+
+		var sentBack interface{}
+		if err := json.Unmarshal([]byte(c.sentBack), &sentBack); err != nil {
+			t.Fatal(err)
+		}
+		state := r.Data(nil)
+		if err := flattenFieldOption(state, "PCF0001", &pagerduty.IncidentCustomFieldOption{
+			ID:   "PFO0001",
+			Data: &pagerduty.IncidentCustomFieldOptionData{DataType: pagerduty.IncidentCustomFieldDataTypeFromString(c.dataType), Value: sentBack},
+		}); err != nil {
+			t.Fatal(err)
+		}
+
+		diff, err := r.Diff(context.Background(), state.State(), sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"field":     "PCF0001",
+			"data_type": c.dataType,
+			"value":     c.value,
+		}), &Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != nil && len(diff.Attributes) > 0 {
+			t.Errorf("%s %q: expected no diff against %q read back, got: %v", c.dataType, c.value, state.Get("value"), diff.Attributes)
+		}
+	}
+
+	_, err := r.Diff(context.Background(), nil, sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+		"field":     "PCF0001",
+		"data_type": "integer",
+		"value":     "five",
+	}), &Config{})
+	if err == nil {
+		t.Errorf("expected an error for a non numeric integer value")
+	}
+}
+
+// Test a rate limited update is retried
+func TestResourcePagerDutyIncidentCustomFieldOptionUpdateRateLimited(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{RetryTime: 30 * time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("ratelimit-reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error": {"code": 2020, "message": "Rate Limit Exceeded"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"field_option": {"id": "PFO0001", "data": {"data_type": "string", "value": "foo"}}}`)
+	})
+
+	d := resourcePagerDutyIncidentCustomFieldOption().Data(nil)
+	d.SetId("PFO0001")
+	d.Set("field", "PCF0001")
+	d.Set("data_type", "string")
+	d.Set("value", "foo")
+
+	if diags := resourcePagerDutyIncidentCustomFieldOptionUpdate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("expected the update to succeed on retry, got: %v", diags)
+	}
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+}
+
+// Test a rate limited delete is retried, deleting a field option already gone
+// succeeds, and a bad request fails without retrying
+func TestResourcePagerDutyIncidentCustomFieldOptionDelete(t *testing.T) {
+	cases := []struct {
+		name         string
+		statuses     []int
+		wantErr      bool
+		wantRequests int32
+	}{
+		{name: "rate limited", statuses: []int{http.StatusTooManyRequests, http.StatusNoContent}, wantRequests: 2},
+		{name: "not found", statuses: []int{http.StatusNotFound}, wantRequests: 1},
+		{name: "bad request", statuses: []int{http.StatusBadRequest}, wantErr: true, wantRequests: 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var requests int32
+			config := newTestConfig(t, &Config{RetryTime: 30 * time.Second}, func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&requests, 1)
+				status := c.statuses[len(c.statuses)-1]
+				if int(n) <= len(c.statuses) {
+					status = c.statuses[n-1]
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("ratelimit-reset", "0")
+				w.WriteHeader(status)
+				if status != http.StatusNoContent {
+					fmt.Fprintf(w, `{"error": {"code": 2001, "message": "%s"}}`, http.StatusText(status))
+				}
+			})
+
+			d := resourcePagerDutyIncidentCustomFieldOption().Data(nil)
+			d.SetId("PFO0001")
+			d.Set("field", "PCF0001")
+
+			diags := resourcePagerDutyIncidentCustomFieldOptionDelete(context.Background(), d, config)
+			if diags.HasError() != c.wantErr {
+				t.Errorf("expected error to be %v, got: %v", c.wantErr, diags)
+			}
+			if !c.wantErr && d.Id() != "" {
+				t.Errorf("expected the id to be cleared, got %q", d.Id())
+			}
+			if got := atomic.LoadInt32(&requests); got != c.wantRequests {
+				t.Errorf("expected %d requests, got %d", c.wantRequests, got)
+			}
+		})
+	}
 }
 
 func testAccExecuteIncidentCustomFieldOptionTest(t *testing.T, fieldName string, dataType pagerduty.IncidentCustomFieldDataType, fieldOptionValue, fieldOptionValueForUpdate string) {
@@ -2,13 +2,22 @@ package pagerduty
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -155,6 +164,435 @@ func TestAccPagerDutyIncidentCustomFields_IllegalDataType(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyIncidentCustomField_RemoveDefaultValue(t *testing.T) {
+	fieldName := fmt.Sprintf("tf_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckIncidentCustomFieldTests(t)
+		},
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckPagerDutyIncidentCustomFieldDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfigWithDefaultValue(fieldName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldExists("pagerduty_incident_custom_field.input"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.input", "default_value", "foo"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyIncidentCustomFieldConfigWithDefaultValue(fieldName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyIncidentCustomFieldExists("pagerduty_incident_custom_field.input"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_incident_custom_field.input", "default_value", ""),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyIncidentCustomFieldConfigWithDefaultValue(fieldName, ""),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// Test removing the default value sends it cleared, without checking it
+// against the options of fixed fields
+func TestResourcePagerDutyIncidentCustomFieldUpdateRemoveDefaultValue(t *testing.T) {
+	for _, fieldType := range []string{"single_value", "single_value_fixed"} {
+		t.Run(fieldType, func(t *testing.T) {
+			var payload map[string]map[string]json.RawMessage
+			config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == http.MethodGet && r.URL.Path == "/incidents/custom_fields/PFIELD1/field_options" {
+					fmt.Fprint(w, `{"field_options": [{"id": "POPT1", "type": "field_option", "data": {"data_type": "string", "value": "bar"}}]}`)
+					return
+				}
+				if r.Method != http.MethodPut {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				body, _ := io.ReadAll(r.Body)
+				if err := json.Unmarshal(body, &payload); err != nil {
+					t.Errorf("unexpected payload %s: %v", body, err)
+				}
+				fmt.Fprintf(w, `{"field": {"id": "PFIELD1", "name": "foo", "display_name": "foo", "data_type": "string", "field_type": %q}}`, fieldType)
+			})
+
+			r := resourcePagerDutyIncidentCustomField()
+			state := r.Data(nil)
+			state.SetId("PFIELD1")
+			state.Set("name", "foo")
+			state.Set("display_name", "foo")
+			state.Set("data_type", "string")
+			state.Set("field_type", fieldType)
+			state.Set("default_value", "bar")
+
+			cfg := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":         "foo",
+				"display_name": "foo",
+				"data_type":    "string",
+				"field_type":   fieldType,
+			})
+			diff, err := r.Diff(context.Background(), state.State(), cfg, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diags := resourcePagerDutyIncidentCustomFieldUpdate(context.Background(), d, config); diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			v, ok := payload["field"]["default_value"]
+			if !ok || string(v) != "null" {
+				t.Errorf("expected default_value to be sent as null, got %q", v)
+			}
+			if got := d.Get("default_value").(string); got != "" {
+				t.Errorf("expected default_value to be cleared, got %q", got)
+			}
+		})
+	}
+}
+
+// Test the default value of a fixed field is checked against its options
+// before being sent
+func TestResourcePagerDutyIncidentCustomFieldUpdateFixedDefaultValue(t *testing.T) {
+	var updates int32
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/incidents/custom_fields/PFIELD1/field_options":
+			fmt.Fprint(w, `{"field_options": [{"id": "PFO0001", "data": {"data_type": "string", "value": "foo"}}, {"id": "PFO0002", "data": {"data_type": "string", "value": "bar"}}]}`)
+		case r.Method == http.MethodPut:
+			atomic.AddInt32(&updates, 1)
+			fmt.Fprint(w, `{"field": {"id": "PFIELD1", "name": "foo", "display_name": "foo", "data_type": "string", "field_type": "multi_value_fixed", "default_value": ["foo", "bar"]}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	data := func(defaultValue string) *schema.ResourceData {
+		d := resourcePagerDutyIncidentCustomField().Data(nil)
+		d.SetId("PFIELD1")
+		d.Set("name", "foo")
+		d.Set("display_name", "foo")
+		d.Set("data_type", "string")
+		d.Set("field_type", "multi_value_fixed")
+		d.Set("default_value", defaultValue)
+		return d
+	}
+
+	diags := resourcePagerDutyIncidentCustomFieldUpdate(context.Background(), data(`["foo", "baz"]`), config)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a default value not among the options")
+	}
+	if !strings.Contains(diags[0].Detail, `"baz"`) || strings.Contains(diags[0].Detail, `"foo" is not`) {
+		t.Errorf("expected the error to name the invalid value only, got: %v", diags[0].Detail)
+	}
+	if !diags[0].AttributePath.Equals(cty.GetAttrPath("default_value")) {
+		t.Errorf("expected the error on default_value, got: %v", diags[0].AttributePath)
+	}
+	if got := atomic.LoadInt32(&updates); got != 0 {
+		t.Errorf("expected no update to be sent, got %d", got)
+	}
+
+	if diags := resourcePagerDutyIncidentCustomFieldUpdate(context.Background(), data(`["foo", "bar"]`), config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got := atomic.LoadInt32(&updates); got != 1 {
+		t.Errorf("expected the update to be sent, got %d", got)
+	}
+
+	// A fixed field has no options when created
+	d := resourcePagerDutyIncidentCustomField().Data(nil)
+	d.Set("name", "foo")
+	d.Set("display_name", "foo")
+	d.Set("data_type", "string")
+	d.Set("field_type", "single_value_fixed")
+	d.Set("default_value", "foo")
+	diags = resourcePagerDutyIncidentCustomFieldCreate(context.Background(), d, config)
+	if !diags.HasError() || !strings.Contains(diags[0].Detail, "pagerduty_incident_custom_field_option") {
+		t.Errorf("expected an error creating a fixed field with a default value, got: %v", diags)
+	}
+}
+
+func TestResourcePagerDutyIncidentCustomFieldBuildDefaultValue(t *testing.T) {
+	cases := []struct {
+		dataType, fieldType, value string
+		want                       interface{}
+	}{
+		{"datetime", "single_value", "2024-01-02T03:04:05Z", "2024-01-02T03:04:05Z"},
+		{"datetime", "multi_value", `["2024-01-02T03:04:05Z"]`, []interface{}{"2024-01-02T03:04:05Z"}},
+		{"integer", "single_value", "5", int64(5)},
+		{"float", "single_value", "3.14", 3.14},
+		{"boolean", "single_value", "false", false},
+		{"string", "single_value", "foo", "foo"},
+	}
+
+	r := resourcePagerDutyIncidentCustomField()
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"name":          "foo",
+			"display_name":  "foo",
+			"data_type":     c.dataType,
+			"field_type":    c.fieldType,
+			"default_value": c.value,
+		})
+		field, err := buildFieldStruct(d)
+		if err != nil {
+			t.Fatalf("%s %q: unexpected error: %v", c.dataType, c.value, err)
+		}
+		if !reflect.DeepEqual(field.DefaultValue, c.want) {
+			t.Errorf("%s %q: expected default value %#v, got %#v", c.dataType, c.value, c.want, field.DefaultValue)
+		}
+	}
+}
+
+func TestResourcePagerDutyIncidentCustomFieldValidateDatetimeDefaultValue(t *testing.T) {
+	r := resourcePagerDutyIncidentCustomField()
+	cfg := func(value string) *sdkterraform.ResourceConfig {
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":          "foo",
+			"display_name":  "foo",
+			"data_type":     "datetime",
+			"field_type":    "single_value",
+			"default_value": value,
+		})
+	}
+
+	if _, err := r.Diff(context.Background(), nil, cfg("2024-01-02T03:04:05Z"), &Config{}); err != nil {
+		t.Errorf("unexpected error for a RFC 3339 datetime: %v", err)
+	}
+	if _, err := r.Diff(context.Background(), nil, cfg("tomorrow"), &Config{}); err == nil {
+		t.Errorf("expected an error for a datetime not in RFC 3339 format")
+	}
+
+	// The same instant sent back in another timezone is not a change
+	state := r.Data(nil)
+	state.SetId("PFIELD1")
+	state.Set("name", "foo")
+	state.Set("display_name", "foo")
+	state.Set("data_type", "datetime")
+	state.Set("field_type", "single_value")
+	state.Set("default_value", "2024-01-02T03:04:05Z")
+
+	diff, err := r.Diff(context.Background(), state.State(), cfg("2024-01-02T04:04:05+01:00"), &Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("expected no diff for the same instant, got: %v", diff.Attributes)
+	}
+
+	diff, err = r.Diff(context.Background(), state.State(), cfg("2024-01-03T03:04:05Z"), &Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff == nil || diff.Attributes["default_value"] == nil {
+		t.Errorf("expected a diff for another instant")
+	}
+}
+
+func TestResourcePagerDutyIncidentCustomFieldFlattenDefaultValue(t *testing.T) {
+	cases := []struct {
+		dataType string
+		value    interface{}
+		want     string
+	}{
+		{"integer", float64(3), "3"},
+		{"integer", float64(12345678), "12345678"},
+		{"float", 3.14, "3.14"},
+		{"float", float64(1000000), "1000000"},
+		{"boolean", true, "true"},
+		{"string", "foo", "foo"},
+	}
+
+	for _, c := range cases {
+		got, err := flattenIncidentCustomFieldValue(c.value, pagerduty.IncidentCustomFieldDataTypeFromString(c.dataType), false)
+		if err != nil {
+			t.Fatalf("%s %v: unexpected error: %v", c.dataType, c.value, err)
+		}
+		if got != c.want {
+			t.Errorf("%s %v: expected %q, got %q", c.dataType, c.value, c.want, got)
+		}
+	}
+}
+
+func TestResourcePagerDutyIncidentCustomFieldValidateScalarDefaultValue(t *testing.T) {
+	r := resourcePagerDutyIncidentCustomField()
+	cfg := func(dataType, value string) *sdkterraform.ResourceConfig {
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":          "foo",
+			"display_name":  "foo",
+			"data_type":     dataType,
+			"field_type":    "single_value",
+			"default_value": value,
+		})
+	}
+
+	cases := []struct {
+		dataType, value string
+		wantErr         *regexp.Regexp
+	}{
+		{"integer", "3", nil},
+		{"integer", "3.5", regexp.MustCompile(`invalid default_value for data_type integer: "3.5" is not a whole number`)},
+		{"integer", "three", regexp.MustCompile(`invalid default_value for data_type integer: "three" is not a whole number`)},
+		{"float", "3.14", nil},
+		{"float", "pi", regexp.MustCompile(`invalid default_value for data_type float: "pi" is not a number`)},
+		{"boolean", "true", nil},
+		{"boolean", "yes", regexp.MustCompile(`invalid default_value for data_type boolean: "yes" is not true or false`)},
+	}
+	for _, c := range cases {
+		_, err := r.Diff(context.Background(), nil, cfg(c.dataType, c.value), &Config{})
+		if c.wantErr == nil {
+			if err != nil {
+				t.Errorf("%s %q: unexpected error: %v", c.dataType, c.value, err)
+			}
+			continue
+		}
+		if err == nil || !c.wantErr.MatchString(err.Error()) {
+			t.Errorf("%s %q: expected error matching %q, got: %v", c.dataType, c.value, c.wantErr, err)
+		}
+	}
+
+	// A value written differently than PagerDuty sends it back is not a change
+	for _, c := range []struct{ dataType, state, config string }{
+		{"integer", "3", "03"},
+		{"float", "3.1", "3.10"},
+		{"float", "1000000", "1e6"},
+		{"boolean", "true", "True"},
+	} {
+		state := r.Data(nil)
+		state.SetId("PFIELD1")
+		state.Set("name", "foo")
+		state.Set("display_name", "foo")
+		state.Set("data_type", c.dataType)
+		state.Set("field_type", "single_value")
+		state.Set("default_value", c.state)
+
+		diff, err := r.Diff(context.Background(), state.State(), cfg(c.dataType, c.config), &Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != nil && len(diff.Attributes) > 0 {
+			t.Errorf("%s %q: expected no diff against %q, got: %v", c.dataType, c.config, c.state, diff.Attributes)
+		}
+	}
+}
+
+func TestResourcePagerDutyIncidentCustomFieldFixedWithoutOptions(t *testing.T) {
+	cases := []struct {
+		name        string
+		fieldType   string
+		options     string
+		wantWarning bool
+	}{
+		{
+			name:        "fixed without options",
+			fieldType:   "single_value_fixed",
+			options:     `[]`,
+			wantWarning: true,
+		},
+		{
+			name:      "fixed with options",
+			fieldType: "multi_value_fixed",
+			options:   `[{"id": "POPT1", "type": "field_option", "data": {"data_type": "string", "value": "bar"}}]`,
+		},
+		{
+			name:      "not fixed",
+			fieldType: "single_value",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/incidents/custom_fields/PFIELD1":
+					fmt.Fprintf(w, `{"field": {"id": "PFIELD1", "name": "foo", "display_name": "foo", "data_type": "string", "field_type": %q}}`, tc.fieldType)
+				case "/incidents/custom_fields/PFIELD1/field_options":
+					if tc.options == "" {
+						t.Errorf("unexpected request of the options of a %s field", tc.fieldType)
+					}
+					fmt.Fprintf(w, `{"field_options": %s}`, tc.options)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := resourcePagerDutyIncidentCustomField().Data(nil)
+			d.SetId("PFIELD1")
+			d.Set("name", "foo")
+			d.Set("display_name", "foo")
+			d.Set("data_type", "string")
+			d.Set("field_type", tc.fieldType)
+
+			diags := resourcePagerDutyIncidentCustomFieldUpdate(context.Background(), d, config)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if gotWarning := len(diags) > 0; gotWarning != tc.wantWarning {
+				t.Errorf("expected warning %t, got %v", tc.wantWarning, diags)
+			}
+
+			// Refreshing the field doesn't repeat the warning
+			if diags := resourcePagerDutyIncidentCustomFieldRead(context.Background(), d, config); len(diags) > 0 {
+				t.Errorf("expected no diagnostics on read, got %v", diags)
+			}
+		})
+	}
+}
+
+func TestResourcePagerDutyIncidentCustomFieldImport(t *testing.T) {
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"fields": [
+			{"id": "PFIELD1", "name": "environment", "display_name": "Environment", "data_type": "string", "field_type": "single_value"},
+			{"id": "PFIELD2", "name": "region", "display_name": "Region", "data_type": "string", "field_type": "single_value"}
+		]}`)
+	})
+
+	cases := []struct {
+		importID string
+		wantID   string
+		wantErr  bool
+	}{
+		{importID: "PFIELD2", wantID: "PFIELD2"},
+		{importID: "region", wantID: "PFIELD2"},
+		{importID: "environment", wantID: "PFIELD1"},
+		{importID: "unknown", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.importID, func(t *testing.T) {
+			d := resourcePagerDutyIncidentCustomField().Data(nil)
+			d.SetId(tc.importID)
+
+			got, err := resourcePagerDutyIncidentCustomFieldImport(context.Background(), d, config)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error importing %q", tc.importID)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != 1 || got[0].Id() != tc.wantID {
+				t.Errorf("expected to import %q, got %q", tc.wantID, d.Id())
+			}
+		})
+	}
+}
+
 func testAccCheckPagerDutyIncidentCustomFieldConfig(name, description, datatype string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_incident_custom_field" "input" {
@@ -190,6 +628,22 @@ resource "pagerduty_incident_custom_field" "input" {
 `, name, datatype, description)
 }
 
+func testAccCheckPagerDutyIncidentCustomFieldConfigWithDefaultValue(name, defaultValue string) string {
+	defaultValueConfig := ""
+	if defaultValue != "" {
+		defaultValueConfig = fmt.Sprintf("default_value = %q", defaultValue)
+	}
+	return fmt.Sprintf(`
+resource "pagerduty_incident_custom_field" "input" {
+  name = "%[1]s"
+  display_name = "%[1]s"
+  data_type = "string"
+  field_type = "single_value"
+  %[2]s
+}
+`, name, defaultValueConfig)
+}
+
 func testAccCheckPagerDutyIncidentCustomFieldDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -438,7 +438,7 @@ func fetchIncidentWorkflow(ctx context.Context, d *schema.ResourceData, meta int
 		return err
 	}
 
-	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	return retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		iw, _, err := client.IncidentWorkflows.GetContext(ctx, d.Id())
 		if err != nil {
 			log.Printf("[WARN] Incident workflow read error")
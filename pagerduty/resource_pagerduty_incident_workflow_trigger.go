@@ -85,6 +85,9 @@ func resourcePagerDutyIncidentWorkflowTriggerCreate(ctx context.Context, d *sche
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := requireIncidentWorkflowTriggerUserEmail(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("[INFO] Creating PagerDuty incident workflow trigger %s for %s.", iwt.Type, iwt.Workflow.ID)
 
@@ -119,6 +122,9 @@ func resourcePagerDutyIncidentWorkflowTriggerUpdate(ctx context.Context, d *sche
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	if err := requireIncidentWorkflowTriggerUserEmail(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
 
 	log.Printf("[INFO] Updating PagerDuty incident workflow trigger %s", d.Id())
 
@@ -176,13 +182,24 @@ func validateIncidentWorkflowTrigger(_ context.Context, d *schema.ResourceDiff,
 	return nil
 }
 
+// requireIncidentWorkflowTriggerUserEmail fails for manual triggers when no
+// user email is configured, as PagerDuty requires a `From` header to manage
+// them and would otherwise reject the request without saying why.
+func requireIncidentWorkflowTriggerUserEmail(d *schema.ResourceData, meta interface{}) error {
+	if pagerduty.IncidentWorkflowTriggerTypeFromString(d.Get("type").(string)) != pagerduty.IncidentWorkflowTriggerTypeManual {
+		return nil
+	}
+	_, err := userEmail(meta)
+	return err
+}
+
 func fetchIncidentWorkflowTrigger(ctx context.Context, d *schema.ResourceData, meta interface{}, errorCallback func(err error, d *schema.ResourceData) error) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
 		return err
 	}
 
-	return retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	return retry.RetryContext(ctx, retryTime(meta), func() *retry.RetryError {
 		iwt, _, err := client.IncidentWorkflowTriggers.GetContext(ctx, d.Id())
 		if err != nil {
 			log.Printf("[WARN] Incident workflow trigger read error")
@@ -1,10 +1,13 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -55,6 +58,42 @@ func testSweepIncidentWorkflowTrigger(region string) error {
 	return nil
 }
 
+// Test a manual trigger isn't created without a user email for the From
+// header, and is sent with it once configured
+func TestResourcePagerDutyIncidentWorkflowTriggerCreateManualFrom(t *testing.T) {
+	var requests int32
+	var from string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		from = r.Header.Get("From")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"trigger": {"id": "PIWT001", "trigger_type": "manual", "workflow": {"id": "PIW0001"}}}`)
+	}
+	config := newTestConfig(t, &Config{}, handler)
+
+	d := resourcePagerDutyIncidentWorkflowTrigger().Data(nil)
+	d.Set("type", "manual")
+	d.Set("workflow", "PIW0001")
+	d.Set("subscribed_to_all_services", true)
+
+	diags := resourcePagerDutyIncidentWorkflowTriggerCreate(context.Background(), d, config)
+	if !diags.HasError() || !strings.Contains(diags[0].Summary, "user_email") {
+		t.Fatalf("expected an error about the missing user email, got: %v", diags)
+	}
+	if got := atomic.LoadInt32(&requests); got != 0 {
+		t.Errorf("expected no request, got %d", got)
+	}
+
+	config = newTestConfig(t, &Config{UserEmail: "foo@example.com"}, handler)
+	if diags := resourcePagerDutyIncidentWorkflowTriggerCreate(context.Background(), d, config); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if from != config.UserEmail {
+		t.Errorf("expected the From header to be %q, got %q", config.UserEmail, from)
+	}
+}
+
 func TestAccPagerDutyIncidentWorkflowTrigger_BadType(t *testing.T) {
 	config := `
 resource "pagerduty_incident_workflow_trigger" "my_first_workflow_trigger" {
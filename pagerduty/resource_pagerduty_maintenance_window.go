@@ -7,9 +7,14 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
+// defaultMaintenanceWindowDescription is the description given to maintenance
+// windows configured without one.
+const defaultMaintenanceWindowDescription = "Managed by Terraform"
+
 func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 	return &schema.Resource{
 		Create: resourcePagerDutyMaintenanceWindowCreate,
@@ -41,9 +46,22 @@ func resourcePagerDutyMaintenanceWindow() *schema.Resource {
 			},
 
 			"description": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsNotEmpty),
+				DiffSuppressFunc: suppressMaintenanceWindowDefaultDescriptionDiff,
+			},
+
+			"html_url": {
 				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "Managed by Terraform",
+				Computed: true,
+			},
+
+			"teams": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
 			},
 		},
 	}
@@ -56,6 +74,9 @@ func buildMaintenanceWindowStruct(d *schema.ResourceData) *pagerduty.Maintenance
 		Services:  expandServices(d.Get("services").(*schema.Set)),
 	}
 
+	// The API keeps the current description when none is sent, so the default
+	// is sent instead to not leave a removed description behind
+	window.Description = defaultMaintenanceWindowDescription
 	if v, ok := d.GetOk("description"); ok {
 		window.Description = v.(string)
 	}
@@ -63,6 +84,12 @@ func buildMaintenanceWindowStruct(d *schema.ResourceData) *pagerduty.Maintenance
 	return window
 }
 
+// suppressMaintenanceWindowDefaultDescriptionDiff hides the description given
+// by the provider to windows configured without one.
+func suppressMaintenanceWindowDefaultDescriptionDiff(_, old, new string, _ *schema.ResourceData) bool {
+	return old == defaultMaintenanceWindowDescription && new == ""
+}
+
 func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).Client()
 	if err != nil {
@@ -73,9 +100,20 @@ func resourcePagerDutyMaintenanceWindowCreate(d *schema.ResourceData, meta inter
 
 	log.Printf("[INFO] Creating PagerDuty maintenance window")
 
-	window, _, err = client.MaintenanceWindows.Create(window)
-	if err != nil {
-		return err
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
+		created, _, err := client.MaintenanceWindows.Create(window)
+		if err != nil {
+			if isErrCode(err, http.StatusBadRequest) {
+				return retry.NonRetryableError(err)
+			}
+
+			return retry.RetryableError(err)
+		}
+		window = created
+		return nil
+	})
+	if retryErr != nil {
+		return retryErr
 	}
 
 	d.SetId(window.ID)
@@ -91,7 +129,7 @@ func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interfa
 
 	log.Printf("[INFO] Reading PagerDuty maintenance window %s", d.Id())
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		window, _, err := client.MaintenanceWindows.Get(d.Id())
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -110,11 +148,16 @@ func resourcePagerDutyMaintenanceWindowRead(d *schema.ResourceData, meta interfa
 		d.Set("description", window.Description)
 		d.Set("start_time", window.StartTime)
 		d.Set("end_time", window.EndTime)
+		d.Set("html_url", window.HTMLURL)
 
 		if err := d.Set("services", flattenServices(window.Services)); err != nil {
 			return retry.NonRetryableError(err)
 		}
 
+		if err := d.Set("teams", flattenTeams(window.Teams)); err != nil {
+			return retry.NonRetryableError(err)
+		}
+
 		return nil
 	})
 }
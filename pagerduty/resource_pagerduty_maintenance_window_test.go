@@ -3,7 +3,9 @@ package pagerduty
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -41,6 +43,87 @@ func testSweepMaintenanceWindow(region string) error {
 	return nil
 }
 
+// Test a rate limited or failed create is retried
+func TestResourcePagerDutyMaintenanceWindowCreateRetries(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{
+		UserEmail: "foo@bar.test",
+		RetryTime: 30 * time.Second,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			w.Header().Set("ratelimit-reset", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+		case 2:
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"maintenance_window":{"id":"PMW0001"}}`))
+		}
+	})
+
+	d := resourcePagerDutyMaintenanceWindow().TestResourceData()
+	d.Set("start_time", "2015-11-09T20:00:00-05:00")
+	d.Set("end_time", "2015-11-09T22:00:00-05:00")
+	d.Set("services", []interface{}{"PXXXXXX"})
+
+	if err := resourcePagerDutyMaintenanceWindowCreate(d, config); err != nil {
+		t.Fatalf("expected the create to succeed on retry, got: %v", err)
+	}
+	if d.Id() != "PMW0001" {
+		t.Errorf("expected the id of the created maintenance window, got %q", d.Id())
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+// Test a bad request fails the create without retrying
+func TestResourcePagerDutyMaintenanceWindowCreateBadRequest(t *testing.T) {
+	var requests int32
+	config := newTestConfig(t, &Config{
+		UserEmail: "foo@bar.test",
+		RetryTime: 30 * time.Second,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":{"code":2001,"message":"Invalid Input Provided"}}`))
+	})
+
+	d := resourcePagerDutyMaintenanceWindow().TestResourceData()
+	d.Set("start_time", "2015-11-09T20:00:00-05:00")
+	d.Set("end_time", "2015-11-09T22:00:00-05:00")
+	d.Set("services", []interface{}{"PXXXXXX"})
+
+	if err := resourcePagerDutyMaintenanceWindowCreate(d, config); err == nil {
+		t.Fatalf("expected the create to fail")
+	}
+	if got := atomic.LoadInt32(&requests); got != 1 {
+		t.Errorf("expected a single request, got %d", got)
+	}
+}
+
+// Test only the description given by the provider is hidden from the diff
+func TestSuppressMaintenanceWindowDefaultDescriptionDiff(t *testing.T) {
+	cases := []struct {
+		old, new string
+		want     bool
+	}{
+		{old: "Managed by Terraform", new: "", want: true},
+		{old: "Managed by Terraform", new: "planned upgrade", want: false},
+		{old: "planned upgrade", new: "", want: false},
+		{old: "", new: "planned upgrade", want: false},
+	}
+
+	for _, c := range cases {
+		if got := suppressMaintenanceWindowDefaultDescriptionDiff("description", c.old, c.new, nil); got != c.want {
+			t.Errorf("expected %q -> %q suppressed to be %v, got %v", c.old, c.new, c.want, got)
+		}
+	}
+}
+
 func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
@@ -58,6 +141,8 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 				Config: testAccCheckPagerDutyMaintenanceWindowConfig(window, windowStartTime, windowEndTime),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttrSet(
+						"pagerduty_maintenance_window.foo", "html_url"),
 				),
 			},
 			{
@@ -70,6 +155,95 @@ func TestAccPagerDutyMaintenanceWindow_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyMaintenanceWindow_CustomDescription(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	description := fmt.Sprintf("%s planned database upgrade", window)
+	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(window, description, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "description", description),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(window, description, windowStartTime, windowEndTime),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(window, "", windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "description", "Managed by Terraform"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(window, "", windowStartTime, windowEndTime),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyMaintenanceWindow_DefaultDescription(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(window, "", windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "description", "Managed by Terraform"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(window, "", windowStartTime, windowEndTime),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyMaintenanceWindow_Teams(t *testing.T) {
+	window := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	windowStartTime := timeNowInAccLoc().Add(24 * time.Hour).Format(time.RFC3339)
+	windowEndTime := timeNowInAccLoc().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyMaintenanceWindowDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyMaintenanceWindowConfigWithTeam(window, windowStartTime, windowEndTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyMaintenanceWindowExists("pagerduty_maintenance_window.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_maintenance_window.foo", "teams.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(
+						"pagerduty_maintenance_window.foo", "teams.*", "pagerduty_team.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutyMaintenanceWindowDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -234,3 +408,101 @@ func testAccCheckPagerDutyAddonDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func testAccCheckPagerDutyMaintenanceWindowConfigWithDescription(name, desc, start, end string) string {
+	description := ""
+	if desc != "" {
+		description = fmt.Sprintf("description = %q", desc)
+	}
+
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name        = "%[1]v"
+  email       = "%[1]v@foo.test"
+  color       = "green"
+  role        = "user"
+  job_title   = "foo"
+  description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name        = "%[1]v"
+  description = "bar"
+  num_loops   = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name                    = "%[1]v"
+  description             = "foo"
+  auto_resolve_timeout    = 1800
+  acknowledgement_timeout = 1800
+  escalation_policy       = pagerduty_escalation_policy.foo.id
+
+  incident_urgency_rule {
+    type    = "constant"
+    urgency = "high"
+  }
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  %[2]v
+  start_time  = "%[3]v"
+  end_time    = "%[4]v"
+  services    = [pagerduty_service.foo.id]
+}
+`, name, description, start, end)
+}
+
+func testAccCheckPagerDutyMaintenanceWindowConfigWithTeam(name, start, end string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+  name = "%[1]v"
+}
+
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_team_membership" "foo" {
+  team_id = pagerduty_team.foo.id
+  user_id = pagerduty_user.foo.id
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]v"
+  num_loops = 2
+  teams     = [pagerduty_team.foo.id]
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_team_membership.foo.user_id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]v"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  description = "%[1]v"
+  start_time  = "%[2]v"
+  end_time    = "%[3]v"
+  services    = [pagerduty_service.foo.id]
+}
+`, name, start, end)
+}
@@ -38,7 +38,8 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 			},
 			"from": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"team": {
 				Type:     schema.TypeString,
@@ -180,10 +181,18 @@ func resourcePagerDutyResponsePlay() *schema.Resource {
 	}
 }
 
-func buildResponsePlayStruct(d *schema.ResourceData) *pagerduty.ResponsePlay {
+func buildResponsePlayStruct(d *schema.ResourceData, meta interface{}) (*pagerduty.ResponsePlay, error) {
+	from := d.Get("from").(string)
+	if from == "" {
+		var err error
+		if from, err = userEmail(meta); err != nil {
+			return nil, err
+		}
+	}
+
 	responsePlay := &pagerduty.ResponsePlay{
 		Name:      d.Get("name").(string),
-		FromEmail: d.Get("from").(string),
+		FromEmail: from,
 	}
 	if attr, ok := d.GetOk("type"); ok {
 		responsePlay.Type = attr.(string)
@@ -224,7 +233,7 @@ func buildResponsePlayStruct(d *schema.ResourceData) *pagerduty.ResponsePlay {
 		responsePlay.ConferenceURL = attr.(string)
 	}
 
-	return responsePlay
+	return responsePlay, nil
 }
 
 func resourcePagerDutyResponsePlayCreate(d *schema.ResourceData, meta interface{}) error {
@@ -233,11 +242,14 @@ func resourcePagerDutyResponsePlayCreate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	responsePlay := buildResponsePlayStruct(d)
+	responsePlay, err := buildResponsePlayStruct(d, meta)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Creating PagerDuty response play: %s", responsePlay.ID)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if responsePlay, _, err := client.ResponsePlays.Create(responsePlay); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -267,7 +279,7 @@ func resourcePagerDutyResponsePlayRead(d *schema.ResourceData, meta interface{})
 	from := d.Get("from").(string)
 	log.Printf("[INFO] Reading PagerDuty response play: %s (from: %s)", d.Id(), from)
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if responsePlay, _, err := client.ResponsePlays.Get(d.Id(), from); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -308,11 +320,14 @@ func resourcePagerDutyResponsePlayUpdate(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	responsePlay := buildResponsePlayStruct(d)
+	responsePlay, err := buildResponsePlayStruct(d, meta)
+	if err != nil {
+		return err
+	}
 
 	log.Printf("[INFO] Updating PagerDuty response play: %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, _, err := client.ResponsePlays.Update(d.Id(), responsePlay); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -338,7 +353,7 @@ func resourcePagerDutyResponsePlayDelete(d *schema.ResourceData, meta interface{
 	log.Printf("[INFO] Deleting PagerDuty response play: %s", d.Id())
 	from := d.Get("from").(string)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.ResponsePlays.Delete(d.Id(), from); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -107,7 +107,7 @@ func fetchPagerDutyRuleset(d *schema.ResourceData, meta interface{}, errCallback
 		return err
 	}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		ruleset, _, err := client.Rulesets.Get(d.Id())
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -145,7 +145,7 @@ func resourcePagerDutyRulesetCreate(d *schema.ResourceData, meta interface{}) er
 
 	log.Printf("[INFO] Creating PagerDuty ruleset: %s", ruleset.Name)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if ruleset, _, err := client.Rulesets.Create(ruleset); err != nil {
 			if isErrCode(err, 400) || isErrCode(err, 429) {
 				return retry.RetryableError(err)
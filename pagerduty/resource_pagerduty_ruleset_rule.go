@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -8,8 +9,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -22,6 +25,7 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyRulesetRuleImport,
 		},
+		CustomizeDiff: checkRuleActionExtractions,
 		Schema: map[string]*schema.Schema{
 			"ruleset": {
 				Type:     schema.TypeString,
@@ -92,15 +96,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"timezone": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-											_, err := time.LoadLocation(val.(string))
-											if err != nil {
-												errs = append(errs, err)
-											}
-											return
-										},
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: util.ValidateTZValueDiagFunc,
 									},
 									"start_time": {
 										Type:     schema.TypeInt,
@@ -155,8 +153,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 										Optional: true,
 									},
 									"threshold_value": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 									},
 									"threshold_time_unit": {
 										Type:     schema.TypeString,
@@ -168,8 +167,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 										}),
 									},
 									"threshold_time_amount": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 									},
 								},
 							},
@@ -274,8 +274,9 @@ func resourcePagerDutyRulesetRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"value": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 									},
 								},
 							},
@@ -533,6 +534,12 @@ func expandSuppress(v interface{}) *pagerduty.RuleActionSuppress {
 	return ras
 }
 
+// checkRuleActionExtractions validates the extractions of ruleset and
+// service event rules the same way event orchestration extractions are.
+func checkRuleActionExtractions(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	return checkExtractionAttributes(diff, "actions.0.extractions")
+}
+
 func expandExtractions(v interface{}) []*pagerduty.RuleActionExtraction {
 	var rae []*pagerduty.RuleActionExtraction
 
@@ -784,7 +791,7 @@ func resourcePagerDutyRulesetRuleCreate(d *schema.ResourceData, meta interface{}
 			return errors.New("No Catch-all rule found. Catch-all Resource must exists")
 		}
 
-		if err := performRulesetRuleUpdate(rule.Ruleset.ID, catchallrule.ID, rule, client); err != nil {
+		if err := performRulesetRuleUpdate(rule.Ruleset.ID, catchallrule.ID, rule, client, meta); err != nil {
 			return err
 		}
 
@@ -793,7 +800,7 @@ func resourcePagerDutyRulesetRuleCreate(d *schema.ResourceData, meta interface{}
 		return resourcePagerDutyRulesetRuleRead(d, meta)
 	}
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if rule, _, err := client.Rulesets.CreateRule(rule.Ruleset.ID, rule); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -828,7 +835,7 @@ func resourcePagerDutyRulesetRuleRead(d *schema.ResourceData, meta interface{})
 	log.Printf("[INFO] Reading PagerDuty ruleset rule: %s", d.Id())
 	rulesetID := d.Get("ruleset").(string)
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if rule, _, err := client.Rulesets.GetRule(rulesetID, d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -868,11 +875,11 @@ func resourcePagerDutyRulesetRuleUpdate(d *schema.ResourceData, meta interface{}
 	log.Printf("[INFO] Updating PagerDuty ruleset rule: %s", d.Id())
 	rulesetID := d.Get("ruleset").(string)
 
-	return performRulesetRuleUpdate(rulesetID, d.Id(), rule, client)
+	return performRulesetRuleUpdate(rulesetID, d.Id(), rule, client, meta)
 }
 
-func performRulesetRuleUpdate(rulesetID string, id string, rule *pagerduty.RulesetRule, client *pagerduty.Client) error {
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+func performRulesetRuleUpdate(rulesetID string, id string, rule *pagerduty.RulesetRule, client *pagerduty.Client, meta interface{}) error {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if updatedRule, _, err := client.Rulesets.UpdateRule(rulesetID, id, rule); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -921,7 +928,7 @@ func resourcePagerDutyRulesetRuleDelete(d *schema.ResourceData, meta interface{}
 		rule.Actions.Suppress.Value = true
 		rule.Actions.Suspend = nil
 
-		if err := performRulesetRuleUpdate(rulesetID, d.Id(), rule, client); err != nil {
+		if err := performRulesetRuleUpdate(rulesetID, d.Id(), rule, client, meta); err != nil {
 			return err
 		}
 
@@ -932,7 +939,7 @@ func resourcePagerDutyRulesetRuleDelete(d *schema.ResourceData, meta interface{}
 
 	log.Printf("[INFO] Deleting PagerDuty ruleset rule: %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Rulesets.DeleteRule(rulesetID, d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
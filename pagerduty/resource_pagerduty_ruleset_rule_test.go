@@ -2,8 +2,11 @@ package pagerduty
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -215,6 +218,192 @@ func TestAccPagerDutyRulesetRule_CatchAllRuleRoute(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyRulesetRule_SuppressAndSuspend(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyRulesetRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		suppress {
+			value = true
+		}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suppress.0.value", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suppress.0.threshold_value", "0"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		suppress {
+			value                 = true
+			threshold_value       = 4
+			threshold_time_amount = 15
+			threshold_time_unit   = "minutes"
+		}
+		suspend {
+			value = 300
+		}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suppress.0.value", "true"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suppress.0.threshold_value", "4"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suppress.0.threshold_time_amount", "15"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suppress.0.threshold_time_unit", "minutes"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.suspend.0.value", "300"),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		suspend {
+			value = 0
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected actions.0.suspend.0.value to be at least \(1\)`),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		suppress {
+			value                 = true
+			threshold_value       = 0
+			threshold_time_amount = 15
+			threshold_time_unit   = "minutes"
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expected actions.0.suppress.0.threshold_value to be at least \(1\)`),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyRulesetRule_Extractions(t *testing.T) {
+	ruleset := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyRulesetRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		extractions {
+			target = "dedup_key"
+			source = "details.host"
+			regex  = "(.*)"
+		}
+		extractions {
+			target   = "summary"
+			template = "{{details.host}} is down"
+		}
+`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyRulesetRuleExists("pagerduty_ruleset_rule.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.extractions.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.extractions.0.source", "details.host"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.extractions.0.regex", "(.*)"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.extractions.0.template", ""),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.extractions.1.template", "{{details.host}} is down"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_ruleset_rule.foo", "actions.0.extractions.1.regex", ""),
+				),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		extractions {
+			target = "dedup_key"
+			source = "details.host"
+			regex  = "(.*)"
+		}
+		extractions {
+			target   = "summary"
+			template = "{{details.host}} is down"
+		}
+`),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		extractions {
+			target = "dedup_key"
+			source = "details.host"
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("regex and template cannot both be null"),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		extractions {
+			target   = "dedup_key"
+			source   = "details.host"
+			regex    = "(.*)"
+			template = "{{details.host}}"
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("regex and template cannot both have values"),
+			},
+			{
+				Config: testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, `
+		extractions {
+			target = "dedup_key"
+			regex  = "(.*)"
+		}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("source can't be blank"),
+			},
+		},
+	})
+}
+
+func TestRuleActionsPositiveIntegerValidation(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"pagerduty_ruleset_rule":       resourcePagerDutyRulesetRule(),
+		"pagerduty_service_event_rule": resourcePagerDutyServiceEventRule(),
+	}
+	attributes := [][2]string{
+		{"suspend", "value"},
+		{"suppress", "threshold_value"},
+		{"suppress", "threshold_time_amount"},
+	}
+
+	for name, r := range resources {
+		actions := r.Schema["actions"].Elem.(*schema.Resource).Schema
+		for _, attr := range attributes {
+			s := actions[attr[0]].Elem.(*schema.Resource).Schema[attr[1]]
+			for v, wantErr := range map[int]bool{-1: true, 0: true, 1: false, 300: false} {
+				diags := s.ValidateDiagFunc(v, cty.GetAttrPath(attr[1]))
+				if diags.HasError() != wantErr {
+					t.Errorf("%s actions.%s.%s = %d: expected error %t, got %v", name, attr[0], attr[1], v, wantErr, diags)
+				}
+			}
+		}
+	}
+}
+
 func testAccCheckPagerDutyRulesetRuleDestroy(s *terraform.State) error {
 	client, _ := testAccProvider.Meta().(*Config).Client()
 	for _, r := range s.RootModule().Resources {
@@ -632,3 +821,39 @@ resource "pagerduty_ruleset_rule" "catch_all" {
 }
 `, team, ruleset, rule1, catch_all_rule)
 }
+
+func testAccCheckPagerDutyRulesetRuleConfigActions(team, ruleset, actions string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_team" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_ruleset" "foo" {
+	name = "%s"
+	team {
+		id = pagerduty_team.foo.id
+	}
+}
+
+resource "pagerduty_ruleset_rule" "foo" {
+	ruleset = pagerduty_ruleset.foo.id
+	position = 0
+	conditions {
+		operator = "and"
+		subconditions {
+			operator = "contains"
+			parameter {
+				value = "disk space"
+				path = "payload.summary"
+			}
+		}
+	}
+	actions {
+		route {
+			value = "P5DTL0K"
+		}
+%s
+	}
+}
+`, team, ruleset, actions)
+}
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -23,26 +24,7 @@ func resourcePagerDutySchedule() *schema.Resource {
 		Update: resourcePagerDutyScheduleUpdate,
 		Delete: resourcePagerDutyScheduleDelete,
 		CustomizeDiff: func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
-			ln := diff.Get("layer.#").(int)
-			for li := 0; li <= ln; li++ {
-				rn := diff.Get(fmt.Sprintf("layer.%d.restriction.#", li)).(int)
-				for ri := 0; ri <= rn; ri++ {
-					t := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.type", li, ri)).(string)
-					isStartDayOfWeekSetWhenDailyRestrictionType := t == "daily_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) != 0
-					if isStartDayOfWeekSetWhenDailyRestrictionType {
-						return fmt.Errorf("start_day_of_week must only be set for a weekly_restriction schedule restriction type")
-					}
-					isStartDayOfWeekNotSetWhenWeeklyRestrictionType := t == "weekly_restriction" && diff.Get(fmt.Sprintf("layer.%d.restriction.%d.start_day_of_week", li, ri)).(int) == 0
-					if isStartDayOfWeekNotSetWhenWeeklyRestrictionType {
-						return fmt.Errorf("start_day_of_week must be set for a weekly_restriction schedule restriction type")
-					}
-					ds := diff.Get(fmt.Sprintf("layer.%d.restriction.%d.duration_seconds", li, ri)).(int)
-					if t == "daily_restriction" && ds >= 3600*24 {
-						return fmt.Errorf("duration_seconds for a daily_restriction schedule restriction type must be shorter than a day")
-					}
-				}
-			}
-			return nil
+			return validateScheduleRestrictions(diff.GetRawConfig())
 		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -201,6 +183,56 @@ func resourcePagerDutySchedule() *schema.Resource {
 	}
 }
 
+// validateScheduleRestrictions checks the fields of the restrictions of the
+// layers in `rawConfig` against their type: only weekly restrictions have a
+// start_day_of_week, and daily restrictions must last less than a day. Values
+// not known until apply are skipped.
+func validateScheduleRestrictions(rawConfig cty.Value) error {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	layers := rawConfig.GetAttr("layer")
+	if layers.IsNull() || !layers.IsKnown() {
+		return nil
+	}
+
+	for li, layer := range layers.AsValueSlice() {
+		restrictions := layer.GetAttr("restriction")
+		if restrictions.IsNull() || !restrictions.IsKnown() {
+			continue
+		}
+		for ri, restriction := range restrictions.AsValueSlice() {
+			t := restriction.GetAttr("type")
+			if t.IsNull() || !t.IsKnown() {
+				continue
+			}
+			prefix := fmt.Sprintf("layer.%d.restriction.%d", li, ri)
+
+			startDayOfWeek := restriction.GetAttr("start_day_of_week")
+			if startDayOfWeek.IsKnown() {
+				switch t.AsString() {
+				case "daily_restriction":
+					if !startDayOfWeek.IsNull() {
+						return fmt.Errorf("%s: start_day_of_week must only be set for a weekly_restriction schedule restriction type", prefix)
+					}
+				case "weekly_restriction":
+					if startDayOfWeek.IsNull() {
+						return fmt.Errorf("%s: start_day_of_week must be set for a weekly_restriction schedule restriction type", prefix)
+					}
+				}
+			}
+
+			duration := restriction.GetAttr("duration_seconds")
+			if t.AsString() == "daily_restriction" && !duration.IsNull() && duration.IsKnown() {
+				if ds, _ := duration.AsBigFloat().Int64(); ds >= 3600*24 {
+					return fmt.Errorf("%s: duration_seconds for a daily_restriction schedule restriction type must be shorter than a day", prefix)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func buildScheduleStruct(d *schema.ResourceData) (*pagerduty.Schedule, error) {
 	layers, err := expandScheduleLayers(d.Get("layer"))
 	if err != nil {
@@ -264,7 +296,7 @@ func fetchSchedule(d *schema.ResourceData, meta interface{}, errCallback func(er
 		return err
 	}
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		schedule, _, err := client.Schedules.Get(d.Id(), &pagerduty.GetScheduleOptions{})
 		if err != nil {
 			log.Printf("[WARN] Schedule read error")
@@ -370,7 +402,7 @@ func resourcePagerDutyScheduleUpdate(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Updating PagerDuty schedule: %s", d.Id())
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, _, err := client.Schedules.Update(d.Id(), schedule, opts); err != nil {
 			return retry.RetryableError(err)
 		}
@@ -393,7 +425,7 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Starting deletion process of Schedule %s", scheduleId)
 	var scheduleData *pagerduty.Schedule
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := client.Schedules.Get(scheduleId, &pagerduty.GetScheduleOptions{})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -419,7 +451,7 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 
 	log.Printf("[INFO] Deleting PagerDuty schedule: %s", scheduleId)
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr = retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr = retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Schedules.Delete(scheduleId); err != nil {
 			if !isErrCode(err, 400) {
 				return retry.RetryableError(err)
@@ -440,7 +472,7 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 			var workaroundErr error
 			// An Schedule with open incidents related can't be remove till those
 			// incidents have been resolved.
-			linksToIncidentsOpen, workaroundErr := listIncidentsOpenedRelatedToSchedule(client, scheduleData, epsUsingThisSchedule)
+			linksToIncidentsOpen, workaroundErr := listIncidentsOpenedRelatedToSchedule(client, meta, scheduleData, epsUsingThisSchedule)
 			if workaroundErr != nil {
 				err = fmt.Errorf("%v; %w", err, workaroundErr)
 				return retry.NonRetryableError(err)
@@ -461,7 +493,7 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 				return retry.NonRetryableError(e)
 			}
 
-			epsDataUsingThisSchedule, errFetchingFullEPs := fetchEPsDataUsingASchedule(epsUsingThisSchedule, client)
+			epsDataUsingThisSchedule, errFetchingFullEPs := fetchEPsDataUsingASchedule(epsUsingThisSchedule, client, meta)
 			if errFetchingFullEPs != nil {
 				err = fmt.Errorf("%v; %w", err, errFetchingFullEPs)
 				return retry.RetryableError(err)
@@ -474,7 +506,7 @@ func resourcePagerDutyScheduleDelete(d *schema.ResourceData, meta interface{}) e
 
 			// Workaround for Schedule being used by escalation policies error
 			log.Printf("[INFO] Dissociating Escalation Policies that use the Schedule: %s", scheduleId)
-			workaroundErr = dissociateScheduleFromEPs(client, scheduleId, epsDataUsingThisSchedule)
+			workaroundErr = dissociateScheduleFromEPs(client, meta, scheduleId, epsDataUsingThisSchedule)
 			if workaroundErr != nil {
 				err = fmt.Errorf("%v; %w", err, workaroundErr)
 			}
@@ -643,6 +675,9 @@ func flattenShedTeams(teams []*pagerduty.TeamReference) []string {
 
 func flattenScheFinalSchedule(finalSche *pagerduty.SubSchedule) []map[string]interface{} {
 	var res []map[string]interface{}
+	if finalSche == nil {
+		return res
+	}
 	elem := make(map[string]interface{})
 	elem["name"] = finalSche.Name
 	elem["rendered_coverage_percentage"] = renderRoundedPercentage(finalSche.RenderedCoveragePercentage)
@@ -651,9 +686,9 @@ func flattenScheFinalSchedule(finalSche *pagerduty.SubSchedule) []map[string]int
 	return res
 }
 
-func listIncidentsOpenedRelatedToSchedule(c *pagerduty.Client, schedule *pagerduty.Schedule, epIDs []string) ([]string, error) {
+func listIncidentsOpenedRelatedToSchedule(c *pagerduty.Client, meta interface{}, schedule *pagerduty.Schedule, epIDs []string) ([]string, error) {
 	var incidents []*pagerduty.Incident
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		var err error
 		options := &pagerduty.ListIncidentsOptions{
 			DateRange: "all",
@@ -712,10 +747,10 @@ func extractEPsUsingASchedule(c *pagerduty.Client, schedule *pagerduty.Schedule)
 	return eps, nil
 }
 
-func dissociateScheduleFromEPs(c *pagerduty.Client, scheduleID string, eps []*pagerduty.EscalationPolicy) error {
+func dissociateScheduleFromEPs(c *pagerduty.Client, meta interface{}, scheduleID string, eps []*pagerduty.EscalationPolicy) error {
 	for _, ep := range eps {
 		errorMessage := fmt.Sprintf("Error while trying to dissociate Schedule %q from Escalation Policy %q", scheduleID, ep.ID)
-		err := removeScheduleFromEP(c, scheduleID, ep)
+		err := removeScheduleFromEP(c, meta, scheduleID, ep)
 		if err != nil {
 			return fmt.Errorf("%w; %s", err, errorMessage)
 		}
@@ -724,7 +759,7 @@ func dissociateScheduleFromEPs(c *pagerduty.Client, scheduleID string, eps []*pa
 	return nil
 }
 
-func removeScheduleFromEP(c *pagerduty.Client, scheduleID string, ep *pagerduty.EscalationPolicy) error {
+func removeScheduleFromEP(c *pagerduty.Client, meta interface{}, scheduleID string, ep *pagerduty.EscalationPolicy) error {
 	needsToUpdate := false
 	epr := ep.EscalationRules
 	// If the Escalation Policy using this Schedule has only one layer then this
@@ -761,7 +796,7 @@ func removeScheduleFromEP(c *pagerduty.Client, scheduleID string, ep *pagerduty.
 	}
 	ep.EscalationRules = epr
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		_, _, err := c.EscalationPolicies.Update(ep.ID, ep)
 		if err != nil {
 			if !isErrCode(err, 404) {
@@ -849,10 +884,10 @@ func detectUseOfScheduleByEPsWithOneLayer(scheduleId string, eps []*pagerduty.Es
 	return displayError
 }
 
-func fetchEPsDataUsingASchedule(eps []string, c *pagerduty.Client) ([]*pagerduty.EscalationPolicy, error) {
+func fetchEPsDataUsingASchedule(eps []string, c *pagerduty.Client, meta interface{}) ([]*pagerduty.EscalationPolicy, error) {
 	fullEPs := []*pagerduty.EscalationPolicy{}
 	for _, epID := range eps {
-		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 			ep, _, err := c.EscalationPolicies.Get(epID, &pagerduty.GetEscalationPolicyOptions{})
 			if err != nil {
 				if isErrCode(err, http.StatusBadRequest) {
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -50,6 +51,99 @@ func testSweepSchedule(region string) error {
 	return nil
 }
 
+func TestFlattenScheFinalSchedule(t *testing.T) {
+	if res := flattenScheFinalSchedule(nil); len(res) != 0 {
+		t.Errorf("expected no final schedule, got %v", res)
+	}
+
+	res := flattenScheFinalSchedule(&pagerduty.SubSchedule{
+		Name:                       "Final Schedule",
+		RenderedCoveragePercentage: 0.5,
+	})
+	if len(res) != 1 {
+		t.Fatalf("expected one final schedule, got %v", res)
+	}
+	if res[0]["name"] != "Final Schedule" || res[0]["rendered_coverage_percentage"] != "50.00" {
+		t.Errorf("unexpected final schedule %v", res[0])
+	}
+}
+
+func TestValidateScheduleRestrictions(t *testing.T) {
+	restriction := func(restrictionType string, startDayOfWeek, durationSeconds cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"type":              cty.StringVal(restrictionType),
+			"start_time_of_day": cty.StringVal("08:00:00"),
+			"start_day_of_week": startDayOfWeek,
+			"duration_seconds":  durationSeconds,
+		})
+	}
+	config := func(restriction cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"layer": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"restriction": cty.ListVal([]cty.Value{restriction}),
+				}),
+			}),
+		})
+	}
+	null := cty.NullVal(cty.Number)
+
+	cases := []struct {
+		name    string
+		config  cty.Value
+		wantErr string
+	}{
+		{
+			name:   "daily",
+			config: config(restriction("daily_restriction", null, cty.NumberIntVal(32400))),
+		},
+		{
+			name:    "daily with start_day_of_week",
+			config:  config(restriction("daily_restriction", cty.NumberIntVal(1), cty.NumberIntVal(32400))),
+			wantErr: "layer.0.restriction.0: start_day_of_week must only be set",
+		},
+		{
+			name:    "daily lasting a day",
+			config:  config(restriction("daily_restriction", null, cty.NumberIntVal(86400))),
+			wantErr: "layer.0.restriction.0: duration_seconds for a daily_restriction",
+		},
+		{
+			name:   "weekly",
+			config: config(restriction("weekly_restriction", cty.NumberIntVal(5), cty.NumberIntVal(86400))),
+		},
+		{
+			name:    "weekly without start_day_of_week",
+			config:  config(restriction("weekly_restriction", null, cty.NumberIntVal(86400))),
+			wantErr: "layer.0.restriction.0: start_day_of_week must be set",
+		},
+		{
+			name:   "weekly with start_day_of_week known after apply",
+			config: config(restriction("weekly_restriction", cty.UnknownVal(cty.Number), cty.NumberIntVal(86400))),
+		},
+		{
+			name: "no layers",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"layer": cty.NullVal(cty.List(cty.EmptyObject)),
+			}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateScheduleRestrictions(c.config)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", c.wantErr, err)
+			}
+		})
+	}
+}
+
 func TestAccPagerDutySchedule_Basic(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
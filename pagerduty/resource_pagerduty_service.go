@@ -18,7 +18,7 @@ import (
 )
 
 func resourcePagerDutyService() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create:        resourcePagerDutyServiceCreate,
 		Read:          resourcePagerDutyServiceRead,
 		Update:        resourcePagerDutyServiceUpdate,
@@ -63,7 +63,7 @@ func resourcePagerDutyService() *schema.Resource {
 					"intelligent",
 					"rules",
 				}),
-				Deprecated:    "Use `alert_grouping_parameters.type`",
+				Deprecated:    "Use `alert_grouping_parameters.type`. Existing services are already tracked with it, so moving the configuration doesn't change them",
 				ConflictsWith: []string{"alert_grouping_parameters"},
 			},
 			"alert_grouping_timeout": {
@@ -307,6 +307,29 @@ func resourcePagerDutyService() *schema.Resource {
 			},
 		},
 	}
+
+	r.SchemaVersion = 1
+	r.StateUpgraders = []schema.StateUpgrader{
+		{
+			Version: 0,
+			Type:    resourcePagerDutyServiceV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourcePagerDutyServiceStateUpgradeV0,
+		},
+	}
+
+	return r
+}
+
+// isLegacyAlertGroupingConfigured reports whether any of the deprecated
+// attributes `alert_grouping` and `alert_grouping_timeout` is present in the
+// resource configuration.
+func isLegacyAlertGroupingConfigured(d *schema.ResourceData) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	return !rawConfig.GetAttr("alert_grouping").IsNull() ||
+		!rawConfig.GetAttr("alert_grouping_timeout").IsNull()
 }
 
 func customizePagerDutyServiceDiff(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
@@ -420,7 +443,10 @@ func buildServiceStruct(d *schema.ResourceData) (*pagerduty.Service, error) {
 		service.AlertGrouping = &ag
 	}
 
-	if attr, ok := d.GetOk("alert_grouping_parameters"); ok {
+	// The parameters found in the state for a configuration still using the
+	// deprecated attributes are the ones computed by PagerDuty, sending them
+	// back would override any change done to the deprecated attributes.
+	if attr, ok := d.GetOk("alert_grouping_parameters"); ok && !isLegacyAlertGroupingConfigured(d) {
 		service.AlertGroupingParameters = expandAlertGroupingParameters(attr)
 	} else {
 		// Clear AlertGroupingParameters as it takes precedence over AlertGrouping and AlertGroupingTimeout which are apparently deprecated (that's not explicitly documented in the API)
@@ -479,7 +505,7 @@ func fetchService(d *schema.ResourceData, meta interface{}, errCallback func(err
 		return err
 	}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		service, _, err := client.Services.Get(d.Id(), &pagerduty.GetServiceOptions{
 			Includes: []string{"auto_pause_notifications_parameters"},
 		})
@@ -601,9 +627,11 @@ func flattenService(d *schema.ResourceData, service *pagerduty.Service) error {
 		d.Set("alert_grouping_timeout", strconv.Itoa(*service.AlertGroupingTimeout))
 	}
 
-	_, hasGrouping := d.GetOk("alert_grouping")
+	// The parameters are computed for configurations still using the
+	// deprecated attributes, and never sent back for them. They are refreshed
+	// when the API returns them, or when the state already tracks them.
 	_, hasGroupingParams := d.GetOk("alert_grouping_parameters")
-	if service.AlertGroupingParameters != nil && (!hasGrouping && hasGroupingParams) {
+	if agp := service.AlertGroupingParameters; agp != nil && (agp.Type != nil || agp.Config != nil || hasGroupingParams) {
 		if err := d.Set("alert_grouping_parameters", flattenAlertGroupingParameters(service.AlertGroupingParameters)); err != nil {
 			return err
 		}
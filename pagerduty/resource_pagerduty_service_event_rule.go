@@ -7,8 +7,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
 
@@ -21,6 +23,7 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutyServiceEventRuleImport,
 		},
+		CustomizeDiff: checkRuleActionExtractions,
 		Schema: map[string]*schema.Schema{
 			"service": {
 				Type:     schema.TypeString,
@@ -87,15 +90,9 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"timezone": {
-										Type:     schema.TypeString,
-										Optional: true,
-										ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
-											_, err := time.LoadLocation(val.(string))
-											if err != nil {
-												errs = append(errs, err)
-											}
-											return
-										},
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: util.ValidateTZValueDiagFunc,
 									},
 									"start_time": {
 										Type:     schema.TypeInt,
@@ -150,8 +147,9 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 										Optional: true,
 									},
 									"threshold_value": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 									},
 									"threshold_time_unit": {
 										Type:     schema.TypeString,
@@ -163,8 +161,9 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 										}),
 									},
 									"threshold_time_amount": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 									},
 								},
 							},
@@ -257,8 +256,9 @@ func resourcePagerDutyServiceEventRule() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"value": {
-										Type:     schema.TypeInt,
-										Optional: true,
+										Type:             schema.TypeInt,
+										Optional:         true,
+										ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
 									},
 								},
 							},
@@ -341,7 +341,7 @@ func resourcePagerDutyServiceEventRuleCreate(d *schema.ResourceData, meta interf
 
 	log.Printf("[INFO] Creating PagerDuty service event rule for service: %s", rule.Service.ID)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if rule, _, err := client.Services.CreateEventRule(rule.Service.ID, rule); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -376,7 +376,7 @@ func resourcePagerDutyServiceEventRuleRead(d *schema.ResourceData, meta interfac
 	log.Printf("[INFO] Reading PagerDuty service event rule: %s", d.Id())
 	serviceID := d.Get("service").(string)
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if rule, _, err := client.Services.GetEventRule(serviceID, d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -416,7 +416,7 @@ func resourcePagerDutyServiceEventRuleUpdate(d *schema.ResourceData, meta interf
 	log.Printf("[INFO] Updating PagerDuty service event rule: %s", d.Id())
 	serviceID := d.Get("service").(string)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if updatedRule, _, err := client.Services.UpdateEventRule(serviceID, d.Id(), rule); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -446,7 +446,7 @@ func resourcePagerDutyServiceEventRuleDelete(d *schema.ResourceData, meta interf
 	log.Printf("[INFO] Deleting PagerDuty service event rule: %s", d.Id())
 	serviceID := d.Get("service").(string)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Services.DeleteEventRule(serviceID, d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
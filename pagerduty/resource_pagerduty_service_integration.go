@@ -7,7 +7,6 @@ import (
 	"log"
 	"net/http"
 	"strings"
-	"time"
 
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -22,7 +21,7 @@ const (
 
 func resourcePagerDutyServiceIntegration() *schema.Resource {
 	return &schema.Resource{
-		Create:        resourcePagerDutyServiceIntegrationCreate,
+		CreateContext: resourcePagerDutyServiceIntegrationCreate,
 		Read:          resourcePagerDutyServiceIntegrationRead,
 		Update:        resourcePagerDutyServiceIntegrationUpdate,
 		Delete:        resourcePagerDutyServiceIntegrationDelete,
@@ -93,6 +92,11 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 					}
 					return diag.Diagnostics{}
 				},
+				// The key is generated by the API and never sent, so once it
+				// is known a different configured value must not plan an update.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old != ""
+				},
 			},
 			"integration_email": {
 				Type:     schema.TypeString,
@@ -107,6 +111,12 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"on_new_email",
+					"on_new_email_subject",
+					"only_if_no_open_incidents",
+					"use_rules",
+				}),
 			},
 			"email_filter_mode": {
 				Type:     schema.TypeString,
@@ -117,6 +127,10 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateDiagFunc: validateValueDiagFunc([]string{
+					"open_new_incident",
+					"discard",
+				}),
 			},
 			"email_parser": {
 				Type:     schema.TypeList,
@@ -275,8 +289,9 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 							Computed: true,
 						},
 						"subject_mode": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEmailFilterReorderingDiff,
 							ValidateDiagFunc: validateValueDiagFunc([]string{
 								"always",
 								"match",
@@ -284,12 +299,14 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 							}),
 						},
 						"subject_regex": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEmailFilterReorderingDiff,
 						},
 						"body_mode": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEmailFilterReorderingDiff,
 							ValidateDiagFunc: validateValueDiagFunc([]string{
 								"always",
 								"match",
@@ -297,12 +314,14 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 							}),
 						},
 						"body_regex": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEmailFilterReorderingDiff,
 						},
 						"from_email_mode": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEmailFilterReorderingDiff,
 							ValidateDiagFunc: validateValueDiagFunc([]string{
 								"always",
 								"match",
@@ -310,8 +329,9 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 							}),
 						},
 						"from_email_regex": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressEmailFilterReorderingDiff,
 						},
 					},
 				},
@@ -320,22 +340,116 @@ func resourcePagerDutyServiceIntegration() *schema.Resource {
 	}
 }
 
-func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
-	flattenEFConfigBlock := func(v interface{}) []map[string]interface{} {
-		var efConfigBlock []map[string]interface{}
-		if isNilFunc(v) {
-			return efConfigBlock
-		}
-		for _, ef := range v.([]interface{}) {
-			var efConfig map[string]interface{}
-			if !isNilFunc(ef) {
-				efConfig = ef.(map[string]interface{})
+// isEmptyIntegrationEmailConfigured reports whether "integration_email" is
+// configured with a known empty value. The attribute is computed, so an empty
+// value is planned as unknown and only the configuration tells it apart from
+// an email known after apply.
+func isEmptyIntegrationEmailConfigured(diff *schema.ResourceDiff) bool {
+	rawConfig := diff.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	raw := rawConfig.GetAttr("integration_email")
+	return raw.IsKnown() && !raw.IsNull() && raw.AsString() == ""
+}
+
+// emailOnlyAttrs are the attributes that only apply to integrations of type
+// generic_email_inbound_integration.
+var emailOnlyAttrs = []string{"email_incident_creation", "email_parsing_fallback"}
+
+// validateEmailOnlyAttrs checks that the attributes in emailOnlyAttrs are only
+// set in `rawConfig` for email integrations. The check is skipped when the
+// type isn't known or the integration is configured through a vendor.
+func validateEmailOnlyAttrs(rawConfig cty.Value) error {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	t := rawConfig.GetAttr("type")
+	if t.IsNull() || !t.IsKnown() || t.AsString() == "generic_email_inbound_integration" {
+		return nil
+	}
+
+	for _, attr := range emailOnlyAttrs {
+		if !rawConfig.GetAttr(attr).IsNull() {
+			return fmt.Errorf("%s can only be set for integrations of type generic_email_inbound_integration, got type %q", attr, t.AsString())
+		}
+	}
+	return nil
+}
+
+// valueExtractorAttrs lists, for each type of value extractor, which of the
+// attributes that locate the value it requires.
+var valueExtractorAttrs = map[string][]string{
+	"between": {"starts_after", "ends_before"},
+	"entire":  {},
+	"regex":   {"regex"},
+}
+
+// validateEmailParserValueExtractors checks that the value extractors of the
+// email parsers in `rawConfig` set exactly the attributes their type uses, so
+// a regex extractor can't also set starts_after and the other way around.
+func validateEmailParserValueExtractors(rawConfig cty.Value) error {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+	parsers := rawConfig.GetAttr("email_parser")
+	if parsers.IsNull() || !parsers.IsKnown() {
+		return nil
+	}
+
+	for pi, parser := range parsers.AsValueSlice() {
+		extractors := parser.GetAttr("value_extractor")
+		if extractors.IsNull() || !extractors.IsKnown() {
+			continue
+		}
+
+		for ei, extractor := range extractors.AsValueSlice() {
+			t := extractor.GetAttr("type")
+			if t.IsNull() || !t.IsKnown() {
+				continue
+			}
+			required, ok := valueExtractorAttrs[t.AsString()]
+			if !ok {
+				continue
+			}
+
+			path := fmt.Sprintf("email_parser.%d.value_extractor.%d", pi, ei)
+			for _, attr := range []string{"ends_before", "regex", "starts_after"} {
+				isSet := !extractor.GetAttr(attr).IsNull()
+				isRequired := false
+				for _, r := range required {
+					isRequired = isRequired || r == attr
+				}
+
+				if isRequired && !isSet {
+					return fmt.Errorf("%s: %s must be set for value extractors of type %q", path, attr, t.AsString())
+				}
+				if !isRequired && isSet {
+					return fmt.Errorf("%s: %s can't be set for value extractors of type %q", path, attr, t.AsString())
+				}
 			}
-			efConfigBlock = append(efConfigBlock, efConfig)
 		}
+	}
+
+	return nil
+}
+
+func flattenEFConfigBlock(v interface{}) []map[string]interface{} {
+	var efConfigBlock []map[string]interface{}
+	if isNilFunc(v) {
 		return efConfigBlock
 	}
+	for _, ef := range v.([]interface{}) {
+		var efConfig map[string]interface{}
+		if !isNilFunc(ef) {
+			efConfig = ef.(map[string]interface{})
+		}
+		efConfigBlock = append(efConfigBlock, efConfig)
+	}
+	return efConfigBlock
+}
 
+func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 	isEFEmptyConfigBlock := func(ef map[string]interface{}) bool {
 		var isEmpty bool
 		if ef["body_mode"].(string) == "" &&
@@ -364,9 +478,15 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 
 	return func(context context.Context, diff *schema.ResourceDiff, i interface{}) error {
 		t := diff.Get("type").(string)
-		if t == "generic_email_inbound_integration" && diff.Get("integration_email").(string) == "" && diff.NewValueKnown("integration_email") {
+		if t == "generic_email_inbound_integration" && isEmptyIntegrationEmailConfigured(diff) {
 			return errors.New(errEmailIntegrationMustHaveEmail)
 		}
+		if err := validateEmailOnlyAttrs(diff.GetRawConfig()); err != nil {
+			return err
+		}
+		if err := validateEmailParserValueExtractors(diff.GetRawConfig()); err != nil {
+			return err
+		}
 
 		// All this custom diff logic is needed because the email_filters API
 		// response returns a default value for its structure even when this
@@ -377,6 +497,7 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 		newEF := flattenEFConfigBlock(vNewEF)
 		if len(oldEF) > 0 && len(newEF) > 0 && len(oldEF) == len(newEF) {
 			var updatedEF []map[string]interface{}
+			var hasDefaultConfig bool
 			for idx, new := range newEF {
 				old := oldEF[idx]
 				isSameEFConfig := old["id"] == new["id"]
@@ -384,17 +505,76 @@ func customizeServiceIntegrationDiff() schema.CustomizeDiffFunc {
 				efConfig := new
 				if isSameEFConfig && isEFDefaultConfigBlock(old) && isEFEmptyConfigBlock(new) {
 					efConfig = old
+					hasDefaultConfig = true
 				}
 				updatedEF = append(updatedEF, efConfig)
 			}
 
-			diff.SetNew("email_filter", updatedEF)
+			// Setting the email filters again would bring back the changes
+			// suppressed by suppressEmailFilterReorderingDiff
+			if hasDefaultConfig {
+				diff.SetNew("email_filter", updatedEF)
+			}
 		}
 
 		return nil
 	}
 }
 
+// emailFilterConfigAttrs are the attributes of an email filter set in HCL,
+// which tell apart one filter from another, as its id is only computed.
+var emailFilterConfigAttrs = []string{
+	"subject_mode",
+	"subject_regex",
+	"body_mode",
+	"body_regex",
+	"from_email_mode",
+	"from_email_regex",
+}
+
+// suppressEmailFilterReorderingDiff ignores the changes of an email filter
+// when the configured filters are the ones in state, only in a different
+// order, as PagerDuty applies them regardless of their order.
+func suppressEmailFilterReorderingDiff(_, _, _ string, d *schema.ResourceData) bool {
+	o, n := d.GetChange("email_filter")
+	return isEmailFilterReordering(flattenEFConfigBlock(o), flattenEFConfigBlock(n))
+}
+
+// isEmailFilterReordering reports whether `new` holds the same email filters
+// of `old`, matched by their configuration, only in a different order.
+func isEmailFilterReordering(old, new []map[string]interface{}) bool {
+	if len(old) != len(new) {
+		return false
+	}
+
+	matched := make([]bool, len(old))
+	for _, n := range new {
+		found := false
+		for i, o := range old {
+			if !matched[i] && isSameEmailFilterConfig(o, n) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func isSameEmailFilterConfig(a, b map[string]interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	for _, attr := range emailFilterConfigAttrs {
+		if a[attr] != b[attr] {
+			return false
+		}
+	}
+	return true
+}
+
 func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integration, error) {
 	serviceIntegration := &pagerduty.Integration{
 		Name: d.Get("name").(string),
@@ -405,10 +585,6 @@ func buildServiceIntegrationStruct(d *schema.ResourceData) (*pagerduty.Integrati
 		},
 	}
 
-	if attr, ok := d.GetOk("integration_key"); ok {
-		serviceIntegration.IntegrationKey = attr.(string)
-	}
-
 	if attr, ok := d.GetOk("integration_email"); ok {
 		serviceIntegration.IntegrationEmail = attr.(string)
 	}
@@ -483,7 +659,11 @@ func expandEmailParsers(v interface{}) ([]*pagerduty.EmailParser, error) {
 				Type: rp["type"].(string),
 			}
 			if predicate.Type == "not" {
-				mp := rp["predicate"].([]interface{})[0].(map[string]interface{})
+				nested, _ := rp["predicate"].([]interface{})
+				if len(nested) < 1 || nested[0] == nil {
+					return nil, fmt.Errorf("email_parser match predicates of type \"not\" require a nested predicate")
+				}
+				mp := nested[0].(map[string]interface{})
 				predicate2 := &pagerduty.Predicate{
 					Type:    mp["type"].(string),
 					Part:    mp["part"].(string),
@@ -650,7 +830,7 @@ func fetchPagerDutyServiceIntegration(d *schema.ResourceData, meta interface{},
 
 	o := &pagerduty.GetIntegrationOptions{}
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		serviceIntegration, _, err := client.Services.GetIntegration(service, d.Id(), o)
 		if err != nil {
 			log.Printf("[WARN] Service integration read error")
@@ -738,22 +918,22 @@ func fetchPagerDutyServiceIntegration(d *schema.ResourceData, meta interface{},
 	})
 }
 
-func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourcePagerDutyServiceIntegrationCreate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client, err := meta.(*Config).Client()
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	serviceIntegration, err := buildServiceIntegrationStruct(d)
 	if err != nil {
-		return err
+		return diag.FromErr(err)
 	}
 
 	log.Printf("[INFO] Creating PagerDuty service integration %s", serviceIntegration.Name)
 
 	service := d.Get("service").(string)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if serviceIntegration, _, err := client.Services.CreateIntegration(service, serviceIntegration); err != nil {
 			if isErrCode(err, 400) {
 				return retry.RetryableError(err)
@@ -767,10 +947,49 @@ func resourcePagerDutyServiceIntegrationCreate(d *schema.ResourceData, meta inte
 	})
 
 	if retryErr != nil {
-		return retryErr
+		return diag.FromErr(retryErr)
 	}
 
-	return fetchPagerDutyServiceIntegration(d, meta, genError)
+	if err := fetchPagerDutyServiceIntegration(d, meta, genError); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return serviceIntegrationDuplicateNameDiagnostics(client, service, d.Id(), serviceIntegration.Name)
+}
+
+// serviceIntegrationDuplicateNameDiagnostics warns when other integrations of
+// `service` have the same name as the integration `id`, since integrations
+// are told apart by name in the web app. Failing to list them only skips the
+// warning.
+func serviceIntegrationDuplicateNameDiagnostics(client *pagerduty.Client, service, id, name string) diag.Diagnostics {
+	if name == "" {
+		return nil
+	}
+
+	s, _, err := client.Services.Get(service, nil)
+	if err != nil {
+		log.Printf("[WARN] Could not list the integrations of service %s: %s", service, err)
+		return nil
+	}
+
+	var duplicates []string
+	for _, i := range s.Integrations {
+		if i != nil && i.ID != id && i.Summary == name {
+			duplicates = append(duplicates, i.ID)
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{
+		{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("Service %s has other integrations named %q", service, name),
+			Detail:        fmt.Sprintf("Integration %s has the same name as %s on this service, so they can't be told apart in the PagerDuty web app. Consider giving it another name.", id, strings.Join(duplicates, ", ")),
+			AttributePath: cty.GetAttrPath("name"),
+		},
+	}
 }
 
 func resourcePagerDutyServiceIntegrationRead(d *schema.ResourceData, meta interface{}) error {
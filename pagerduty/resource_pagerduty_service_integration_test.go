@@ -1,11 +1,21 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"os"
 	"regexp"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -243,6 +253,11 @@ func TestAccPagerDutyServiceIntegrationEmail_Filters(t *testing.T) {
 						"pagerduty_service_integration.foo", "email_parser.1.value_extractor.1.value_name", "FieldName1"),
 				),
 			},
+			// Validating that reordering the email filters doesn't plan changes
+			{
+				Config:   testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigReordered(username, email, escalationPolicy, service, serviceIntegration, testAccGetPagerDutyAccountDomain(t)),
+				PlanOnly: true,
+			},
 			{
 				Config: testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigUpdated(username, email, escalationPolicy, service, serviceIntegrationUpdated, testAccGetPagerDutyAccountDomain(t)),
 				Check: resource.ComposeTestCheckFunc(
@@ -863,6 +878,15 @@ resource "pagerduty_service_integration" "foo" {
 `, username, email, escalationPolicy, service, serviceIntegration, accountDomain)
 }
 
+// testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigReordered swaps
+// the two email filters of testAccCheckPagerDutyServiceIntegrationEmailFiltersConfig.
+func testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigReordered(username, email, escalationPolicy, service, serviceIntegration string, accountDomain string) string {
+	return strings.NewReplacer(
+		`"(@foo.test*)"`, `"(@bar.com*)"`,
+		`"(@bar.com*)"`, `"(@foo.test*)"`,
+	).Replace(testAccCheckPagerDutyServiceIntegrationEmailFiltersConfig(username, email, escalationPolicy, service, serviceIntegration, accountDomain))
+}
+
 func testAccCheckPagerDutyServiceIntegrationEmailFiltersConfigUpdated(username, email, escalationPolicy, service, serviceIntegration string, accountDomain string) string {
 	return fmt.Sprintf(`
 data "pagerduty_vendor" "email" {
@@ -1125,3 +1149,666 @@ func testAccPreCheckServiceIntegrationGenericEmailNoFilters(t *testing.T) {
 		t.Skip("PAGERDUTY_ACC_SERVICE_INTEGRATION_GENERIC_EMAIL_NO_FILTERS not set. Skipping Service Integration related test")
 	}
 }
+
+// Test a service integration created but failing to be read right after is
+// kept in the state, instead of being orphaned in PagerDuty.
+func TestResourcePagerDutyServiceIntegrationCreateReadFailure(t *testing.T) {
+	config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"integration": {"id": "PINTEGR", "type": "generic_events_api_inbound_integration"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyServiceIntegration().Schema, map[string]interface{}{
+		"service": "PSERVIC",
+		"type":    "generic_events_api_inbound_integration",
+	})
+
+	if diags := resourcePagerDutyServiceIntegrationCreate(context.Background(), d, config); !diags.HasError() {
+		t.Fatalf("expected the create to fail reading the service integration")
+	}
+	if d.Id() != "PINTEGR" {
+		t.Errorf("expected the created service integration id to be kept, got %q", d.Id())
+	}
+}
+
+// Test creating a service integration named like another one of the service
+// warns about it
+func TestResourcePagerDutyServiceIntegrationCreateDuplicateName(t *testing.T) {
+	cases := []struct {
+		name        string
+		wantWarning bool
+	}{
+		{name: "foo", wantWarning: true},
+		{name: "bar"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			config := newTestConfig(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				switch {
+				case r.Method == http.MethodPost:
+					w.WriteHeader(http.StatusCreated)
+					fmt.Fprintf(w, `{"integration": {"id": "PINTEG2", "name": %q, "type": "generic_events_api_inbound_integration"}}`, c.name)
+				case r.URL.Path == "/services/PSERVIC/integrations/PINTEG2":
+					fmt.Fprintf(w, `{"integration": {"id": "PINTEG2", "name": %q, "type": "generic_events_api_inbound_integration", "service": {"id": "PSERVIC"}}}`, c.name)
+				case r.URL.Path == "/services/PSERVIC":
+					fmt.Fprintf(w, `{"service": {"id": "PSERVIC", "integrations": [{"id": "PINTEG1", "summary": "foo", "type": "generic_events_api_inbound_integration_reference"}, {"id": "PINTEG2", "summary": %q, "type": "generic_events_api_inbound_integration_reference"}]}}`, c.name)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, resourcePagerDutyServiceIntegration().Schema, map[string]interface{}{
+				"name":    c.name,
+				"service": "PSERVIC",
+				"type":    "generic_events_api_inbound_integration",
+			})
+
+			diags := resourcePagerDutyServiceIntegrationCreate(context.Background(), d, config)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !c.wantWarning {
+				if len(diags) > 0 {
+					t.Errorf("expected no warning, got: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "PINTEG1") {
+				t.Errorf("expected a warning naming the other integration, got: %v", diags)
+			}
+		})
+	}
+}
+
+func TestBuildServiceIntegrationStructIgnoresIntegrationKey(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyServiceIntegration().Schema, map[string]interface{}{
+		"service":         "PSERVIC",
+		"type":            "generic_events_api_inbound_integration",
+		"integration_key": "user-provided",
+	})
+
+	serviceIntegration, err := buildServiceIntegrationStruct(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if serviceIntegration.IntegrationKey != "" {
+		t.Errorf("expected integration_key not to be sent, got %q", serviceIntegration.IntegrationKey)
+	}
+
+	suppress := resourcePagerDutyServiceIntegration().Schema["integration_key"].DiffSuppressFunc
+	if !suppress("integration_key", "generated", "user-provided", d) {
+		t.Errorf("expected a configured integration_key not to replace the generated one")
+	}
+}
+
+// Test an email integration with a known empty email fails at plan time
+func TestResourcePagerDutyServiceIntegrationDiffEmptyEmail(t *testing.T) {
+	cases := []struct {
+		name    string
+		config  map[string]cty.Value
+		wantErr bool
+	}{
+		{
+			name: "email integration with empty email",
+			config: map[string]cty.Value{
+				"type":              cty.StringVal("generic_email_inbound_integration"),
+				"integration_email": cty.StringVal(""),
+			},
+			wantErr: true,
+		},
+		{
+			name: "email integration with email",
+			config: map[string]cty.Value{
+				"type":              cty.StringVal("generic_email_inbound_integration"),
+				"integration_email": cty.StringVal("foo@example.pagerduty.com"),
+			},
+		},
+		{
+			name: "email integration with email known after apply",
+			config: map[string]cty.Value{
+				"type":              cty.StringVal("generic_email_inbound_integration"),
+				"integration_email": cty.UnknownVal(cty.String),
+			},
+		},
+		{
+			name: "events integration with empty email",
+			config: map[string]cty.Value{
+				"type":              cty.StringVal("generic_events_api_inbound_integration"),
+				"integration_email": cty.StringVal(""),
+			},
+		},
+	}
+
+	r := resourcePagerDutyServiceIntegration()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			attrs := map[string]cty.Value{}
+			for k, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+				attrs[k] = cty.NullVal(ty)
+			}
+			attrs["service"] = cty.StringVal("PSERVICE")
+			for k, v := range c.config {
+				attrs[k] = v
+			}
+			rawConfig := cty.ObjectVal(attrs)
+
+			raw := map[string]interface{}{"service": "PSERVICE"}
+			for k, v := range c.config {
+				if v.IsKnown() {
+					raw[k] = v.AsString()
+				}
+			}
+			cfg := sdkterraform.NewResourceConfigRaw(raw)
+
+			_, err := r.Diff(context.Background(), &sdkterraform.InstanceState{RawConfig: rawConfig}, cfg, &Config{})
+			if c.wantErr {
+				if err == nil || !strings.Contains(err.Error(), errEmailIntegrationMustHaveEmail) {
+					t.Errorf("expected error %q, got %v", errEmailIntegrationMustHaveEmail, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestIsEmailFilterReordering(t *testing.T) {
+	filter := func(fromEmailRegex string) map[string]interface{} {
+		return map[string]interface{}{
+			"id":               "",
+			"subject_mode":     "match",
+			"subject_regex":    "(CRITICAL*)",
+			"body_mode":        "always",
+			"body_regex":       "",
+			"from_email_mode":  "match",
+			"from_email_regex": fromEmailRegex,
+		}
+	}
+	withID := func(ef map[string]interface{}, id string) map[string]interface{} {
+		ef["id"] = id
+		return ef
+	}
+	old := []map[string]interface{}{
+		withID(filter("(@foo.test*)"), "PEF001"),
+		withID(filter("(@bar.com*)"), "PEF002"),
+	}
+
+	cases := []struct {
+		name string
+		new  []map[string]interface{}
+		want bool
+	}{
+		{
+			name: "same order",
+			new:  []map[string]interface{}{filter("(@foo.test*)"), filter("(@bar.com*)")},
+			want: true,
+		},
+		{
+			name: "reordered",
+			new:  []map[string]interface{}{filter("(@bar.com*)"), filter("(@foo.test*)")},
+			want: true,
+		},
+		{
+			name: "changed",
+			new:  []map[string]interface{}{filter("(@bar.com*)"), filter("(@baz.com*)")},
+		},
+		{
+			name: "duplicated",
+			new:  []map[string]interface{}{filter("(@bar.com*)"), filter("(@bar.com*)")},
+		},
+		{
+			name: "removed",
+			new:  []map[string]interface{}{filter("(@bar.com*)")},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := isEmailFilterReordering(old, c.new); got != c.want {
+				t.Errorf("expected %v, got %v", c.want, got)
+			}
+		})
+	}
+}
+
+func TestResourcePagerDutyServiceIntegrationDiffEmailFilterReordering(t *testing.T) {
+	filter := func(fromEmailRegex string) map[string]interface{} {
+		return map[string]interface{}{
+			"subject_mode":     "match",
+			"subject_regex":    "(CRITICAL*)",
+			"body_mode":        "always",
+			"from_email_mode":  "match",
+			"from_email_regex": fromEmailRegex,
+		}
+	}
+
+	r := resourcePagerDutyServiceIntegration()
+	state := r.Data(nil)
+	state.SetId("PINT001")
+	state.Set("service", "PSERVICE")
+	state.Set("type", "generic_email_inbound_integration")
+	state.Set("integration_email", "foo@bar.test")
+	stateFilters := []interface{}{filter("(@foo.test*)"), filter("(@bar.com*)")}
+	for i, ef := range stateFilters {
+		ef.(map[string]interface{})["id"] = fmt.Sprintf("PEF00%d", i+1)
+	}
+	state.Set("email_filter", stateFilters)
+
+	cases := []struct {
+		name     string
+		filters  []interface{}
+		wantDiff bool
+	}{
+		{name: "reordered", filters: []interface{}{filter("(@bar.com*)"), filter("(@foo.test*)")}},
+		{name: "changed", filters: []interface{}{filter("(@bar.com*)"), filter("(@baz.com*)")}, wantDiff: true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cfg := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+				"service":           "PSERVICE",
+				"type":              "generic_email_inbound_integration",
+				"integration_email": "foo@bar.test",
+				"email_filter":      c.filters,
+			})
+			diff, err := r.Diff(context.Background(), state.State(), cfg, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hasDiff := false
+			if diff != nil {
+				for k := range diff.Attributes {
+					if strings.HasPrefix(k, "email_filter.") {
+						hasDiff = true
+					}
+				}
+			}
+			if hasDiff != c.wantDiff {
+				t.Errorf("expected a diff of email_filter to be %v, got: %v", c.wantDiff, diff)
+			}
+		})
+	}
+}
+
+func TestResourcePagerDutyServiceIntegrationValidateEmailParser(t *testing.T) {
+	emailParser := func(action, matchPredicateType string) map[string]interface{} {
+		return map[string]interface{}{
+			"service": "PSERVICE",
+			"email_parser": []interface{}{
+				map[string]interface{}{
+					"action": action,
+					"match_predicate": []interface{}{
+						map[string]interface{}{
+							"type": matchPredicateType,
+							"predicate": []interface{}{
+								map[string]interface{}{
+									"matcher": "foo",
+									"part":    "subject",
+									"type":    "contains",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		wantPath string
+	}{
+		{
+			name:   "valid",
+			config: emailParser("resolve", "all"),
+		},
+		{
+			name:     "invalid action",
+			config:   emailParser("acknowledge", "any"),
+			wantPath: "email_parser.0.action",
+		},
+		{
+			name:     "invalid match predicate type",
+			config:   emailParser("trigger", "none"),
+			wantPath: "email_parser.0.match_predicate.0.type",
+		},
+	}
+
+	r := resourcePagerDutyServiceIntegration()
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := r.Validate(sdkterraform.NewResourceConfigRaw(c.config))
+			if c.wantPath == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected errors: %v", diags)
+				}
+				return
+			}
+			if len(diags) != 1 || !diags.HasError() {
+				t.Fatalf("expected one error, got %v", diags)
+			}
+			if got := attributePathString(diags[0].AttributePath); got != c.wantPath {
+				t.Errorf("expected error on %s, got %s", c.wantPath, got)
+			}
+		})
+	}
+}
+
+func attributePathString(p cty.Path) string {
+	var parts []string
+	for _, step := range p {
+		switch s := step.(type) {
+		case cty.GetAttrStep:
+			parts = append(parts, s.Name)
+		case cty.IndexStep:
+			i, _ := s.Key.AsBigFloat().Int64()
+			parts = append(parts, strconv.FormatInt(i, 10))
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+func TestValidateEmailParserValueExtractors(t *testing.T) {
+	extractor := func(extractorType string, attrs map[string]cty.Value) cty.Value {
+		v := map[string]cty.Value{
+			"type":         cty.StringVal(extractorType),
+			"part":         cty.StringVal("subject"),
+			"value_name":   cty.StringVal("incident_key"),
+			"ends_before":  cty.NullVal(cty.String),
+			"regex":        cty.NullVal(cty.String),
+			"starts_after": cty.NullVal(cty.String),
+		}
+		for k, a := range attrs {
+			v[k] = a
+		}
+		return cty.ObjectVal(v)
+	}
+	config := func(extractor cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"email_parser": cty.ListVal([]cty.Value{
+				cty.ObjectVal(map[string]cty.Value{
+					"value_extractor": cty.ListVal([]cty.Value{extractor}),
+				}),
+			}),
+		})
+	}
+
+	cases := []struct {
+		name    string
+		config  cty.Value
+		wantErr string
+	}{
+		{
+			name: "between",
+			config: config(extractor("between", map[string]cty.Value{
+				"starts_after": cty.StringVal("start"),
+				"ends_before":  cty.StringVal("end"),
+			})),
+		},
+		{
+			name: "between without ends_before",
+			config: config(extractor("between", map[string]cty.Value{
+				"starts_after": cty.StringVal("start"),
+			})),
+			wantErr: "ends_before must be set",
+		},
+		{
+			name: "between with regex",
+			config: config(extractor("between", map[string]cty.Value{
+				"starts_after": cty.StringVal("start"),
+				"ends_before":  cty.StringVal("end"),
+				"regex":        cty.StringVal("(foo*)"),
+			})),
+			wantErr: "regex can't be set",
+		},
+		{
+			name: "regex",
+			config: config(extractor("regex", map[string]cty.Value{
+				"regex": cty.StringVal("(foo*)"),
+			})),
+		},
+		{
+			name: "regex known after apply",
+			config: config(extractor("regex", map[string]cty.Value{
+				"regex": cty.UnknownVal(cty.String),
+			})),
+		},
+		{
+			name:    "regex without regex",
+			config:  config(extractor("regex", nil)),
+			wantErr: "regex must be set",
+		},
+		{
+			name: "regex with starts_after",
+			config: config(extractor("regex", map[string]cty.Value{
+				"regex":        cty.StringVal("(foo*)"),
+				"starts_after": cty.StringVal("start"),
+			})),
+			wantErr: "starts_after can't be set",
+		},
+		{
+			name:   "entire",
+			config: config(extractor("entire", nil)),
+		},
+		{
+			name: "entire with ends_before",
+			config: config(extractor("entire", map[string]cty.Value{
+				"ends_before": cty.StringVal("end"),
+			})),
+			wantErr: "ends_before can't be set",
+		},
+		{
+			name: "no email parsers",
+			config: cty.ObjectVal(map[string]cty.Value{
+				"email_parser": cty.NullVal(cty.List(cty.EmptyObject)),
+			}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateEmailParserValueExtractors(c.config)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("expected error %q, got %v", c.wantErr, err)
+			}
+			if err != nil && !strings.HasPrefix(err.Error(), "email_parser.0.value_extractor.0: ") {
+				t.Errorf("expected error on email_parser.0.value_extractor.0, got %v", err)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenEmailParsers(t *testing.T) {
+	emailParser := func(predicate map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"service": "PSERVICE",
+			"email_parser": []interface{}{
+				map[string]interface{}{
+					"action": "trigger",
+					"match_predicate": []interface{}{
+						map[string]interface{}{
+							"type":      "all",
+							"predicate": []interface{}{predicate},
+						},
+					},
+					"value_extractor": []interface{}{
+						map[string]interface{}{
+							"type":         "between",
+							"part":         "subject",
+							"starts_after": "start",
+							"ends_before":  "end",
+							"value_name":   "incident_key",
+						},
+						map[string]interface{}{
+							"type":       "regex",
+							"part":       "body",
+							"regex":      "(foo*)",
+							"value_name": "FieldName1",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	r := resourcePagerDutyServiceIntegration()
+	raw := emailParser(map[string]interface{}{
+		"type": "not",
+		"predicate": []interface{}{
+			map[string]interface{}{
+				"type":    "regex",
+				"part":    "from_addresses",
+				"matcher": "(bar*)",
+			},
+		},
+	})
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+
+	parsers, err := expandEmailParsers(d.Get("email_parser"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	not := parsers[0].MatchPredicate.Predicates[0]
+	if not.Part != "" || not.Matcher != "" || len(not.Predicates) != 1 {
+		t.Fatalf("expected the not predicate to only wrap a nested predicate, got %+v", not)
+	}
+	if p := not.Predicates[0]; p.Type != "regex" || p.Part != "from_addresses" || p.Matcher != "(bar*)" {
+		t.Errorf("unexpected nested predicate %+v", p)
+	}
+
+	// The API assigns integer IDs to the email parsers
+	id := 1234
+	parsers[0].ID = &id
+	if err := d.Set("email_parser", flattenEmailParsers(parsers)); err != nil {
+		t.Fatalf("unexpected error setting the flattened email parsers: %v", err)
+	}
+	if got := d.Get("email_parser.0.id").(int); got != id {
+		t.Errorf("expected email parser id %d, got %d", id, got)
+	}
+
+	roundTrip, err := expandEmailParsers(d.Get("email_parser"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected, _ := json.Marshal(parsers)
+	got, _ := json.Marshal(roundTrip)
+	if string(expected) != string(got) {
+		t.Errorf("expected the email parsers to round-trip\nexpected: %s\ngot:      %s", expected, got)
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, emailParser(map[string]interface{}{"type": "not"}))
+	if _, err := expandEmailParsers(d.Get("email_parser")); err == nil {
+		t.Errorf("expected an error for a not predicate without a nested predicate")
+	}
+}
+
+func TestExpandFlattenEmailFilters(t *testing.T) {
+	filters := []*pagerduty.EmailFilter{
+		{
+			ID:             "PEF0001",
+			SubjectMode:    "match",
+			SubjectRegex:   "(CRITICAL*)",
+			BodyMode:       "always",
+			FromEmailMode:  "match",
+			FromEmailRegex: "(@foo.test*)",
+		},
+		{
+			ID:             "PEF0002",
+			SubjectMode:    "match",
+			SubjectRegex:   "(CRITICAL*)",
+			BodyMode:       "always",
+			FromEmailMode:  "match",
+			FromEmailRegex: "(@bar.com*)",
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutyServiceIntegration().Schema, map[string]interface{}{"service": "PSERVICE"})
+	if err := d.Set("email_filter", flattenEmailFilters(filters)); err != nil {
+		t.Fatalf("unexpected error setting the flattened email filters: %v", err)
+	}
+
+	got, err := expandEmailFilters(d.Get("email_filter"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected, _ := json.Marshal(filters)
+	actual, _ := json.Marshal(got)
+	if string(expected) != string(actual) {
+		t.Errorf("expected the email filters to keep their ids\nexpected: %s\ngot:      %s", expected, actual)
+	}
+}
+
+func TestValidateEmailOnlyAttrs(t *testing.T) {
+	config := func(integrationType cty.Value, incidentCreation, parsingFallback cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"type":                    integrationType,
+			"email_incident_creation": incidentCreation,
+			"email_parsing_fallback":  parsingFallback,
+		})
+	}
+	null := cty.NullVal(cty.String)
+
+	cases := []struct {
+		name    string
+		config  cty.Value
+		wantErr string
+	}{
+		{
+			name:   "email integration",
+			config: config(cty.StringVal("generic_email_inbound_integration"), cty.StringVal("use_rules"), cty.StringVal("discard")),
+		},
+		{
+			name:   "events integration without email attributes",
+			config: config(cty.StringVal("events_api_v2_inbound_integration"), null, null),
+		},
+		{
+			name:    "events integration with email_incident_creation",
+			config:  config(cty.StringVal("events_api_v2_inbound_integration"), cty.StringVal("on_new_email"), null),
+			wantErr: "email_incident_creation can only be set",
+		},
+		{
+			name:    "events integration with email_parsing_fallback",
+			config:  config(cty.StringVal("events_api_v2_inbound_integration"), null, cty.StringVal("open_new_incident")),
+			wantErr: "email_parsing_fallback can only be set",
+		},
+		{
+			name:   "vendor integration",
+			config: config(null, cty.StringVal("on_new_email"), null),
+		},
+		{
+			name:   "type known after apply",
+			config: config(cty.UnknownVal(cty.String), cty.StringVal("on_new_email"), null),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := validateEmailOnlyAttrs(c.config)
+			if c.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", c.wantErr, err)
+			}
+		})
+	}
+}
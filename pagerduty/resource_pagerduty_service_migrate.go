@@ -0,0 +1,281 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourcePagerDutyServiceStateUpgradeV0 carries the deprecated
+// `alert_grouping` and `alert_grouping_timeout` attributes stored in the state
+// over to their `alert_grouping_parameters` equivalent, so configurations
+// migrating to the later don't produce a diff for an unchanged Alert Grouping
+// setup. The deprecated attributes are kept for configurations still using
+// them.
+func resourcePagerDutyServiceStateUpgradeV0(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	agp, _ := rawState["alert_grouping_parameters"].([]interface{})
+	ag, _ := rawState["alert_grouping"].(string)
+	if len(agp) > 0 || (ag != "time" && ag != "intelligent") {
+		return rawState, nil
+	}
+
+	log.Printf("[WARN] PagerDuty service %v uses the deprecated alert_grouping %q, which is now also tracked as alert_grouping_parameters. Move its configuration to alert_grouping_parameters, as alert_grouping and alert_grouping_timeout will be removed", rawState["id"], ag)
+
+	config := map[string]interface{}{}
+	if agt, ok := rawState["alert_grouping_timeout"].(string); ok && ag == "time" && agt != "" && agt != "null" {
+		timeout, err := strconv.Atoi(agt)
+		if err != nil {
+			return nil, err
+		}
+		config["timeout"] = timeout
+	}
+
+	rawState["alert_grouping_parameters"] = []interface{}{
+		map[string]interface{}{
+			"type":   ag,
+			"config": []interface{}{config},
+		},
+	}
+	return rawState, nil
+}
+
+// resourcePagerDutyServiceV0 is the schema of the service resource at version
+// 0, kept to decode the states stored with it.
+func resourcePagerDutyServiceV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"html_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"alert_creation": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"alert_grouping": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"alert_grouping_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"alert_grouping_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"timeout": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"fields": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"aggregate": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"time_window": {
+										Type:     schema.TypeInt,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"auto_pause_notifications_parameters": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"timeout": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"auto_resolve_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_incident_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"acknowledgement_timeout": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"escalation_policy": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"incident_urgency_rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"urgency": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"during_support_hours":  resourcePagerDutyServiceV0UrgencySchema(),
+						"outside_support_hours": resourcePagerDutyServiceV0UrgencySchema(),
+					},
+				},
+			},
+			"support_hours": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"time_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"days_of_week": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 7,
+							Elem:     &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"scheduled_actions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"to_urgency": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"at": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"response_play": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePagerDutyServiceV0UrgencySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"urgency": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
@@ -1,14 +1,21 @@
 package pagerduty
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"regexp"
 	"strings"
 	"testing"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/heimweh/go-pagerduty/pagerduty"
 )
@@ -130,6 +137,35 @@ func TestAccPagerDutyService_Basic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyService_DefaultTimeouts(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyServiceConfigWithoutTimeouts(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyServiceExists("pagerduty_service.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "auto_resolve_timeout", "14400"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "acknowledgement_timeout", "1800"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutyServiceConfigWithoutTimeouts(username, email, escalationPolicy, service),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_FormatValidation(t *testing.T) {
 	service := fmt.Sprintf("ts-%s", acctest.RandString(5))
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -1260,6 +1296,48 @@ func TestAccPagerDutyService_ResponsePlay(t *testing.T) {
 
 }
 
+// Test a configuration using the deprecated alert_grouping attributes,
+// applied with a release storing the service state at schema version 0, can
+// move to alert_grouping_parameters without changing the service.
+func TestAccPagerDutyService_AlertGroupingStateUpgradeV0(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckPagerDutyServiceDestroy,
+		Steps: []resource.TestStep{
+			{
+				ExternalProviders: map[string]resource.ExternalProvider{
+					"pagerduty": {Source: "pagerduty/pagerduty", VersionConstraint: "~> 3.6"},
+				},
+				Config: testAccCheckPagerDutyServiceConfigWithAlertGrouping(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping", "time"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_timeout", "1800"),
+				),
+			},
+			{
+				ProviderFactories: testAccProviderFactories,
+				Config:            testAccCheckPagerDutyServiceConfigWithAlertGroupingParametersTime(username, email, escalationPolicy, service),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.type", "time"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service.foo", "alert_grouping_parameters.0.config.0.timeout", "1800"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccPagerDutyService_AlertGroupingParametersAddConfigField(t *testing.T) {
 	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
 	email := fmt.Sprintf("%s@foo.test", username)
@@ -1428,6 +1506,38 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceConfigWithoutTimeouts(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	description       = "foo"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+`, username, email, escalationPolicy, service)
+}
+
 func testAccCheckPagerDutyServiceCustomInputValidationConfig(username, email, escalationPolicy, service, customAdditionalServiceConfig string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -1501,6 +1611,47 @@ resource "pagerduty_service" "foo" {
 `, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyServiceConfigWithAlertGroupingParametersTime(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name        = "%s"
+	email       = "%s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name                    = "%s"
+	description             = "foo"
+	auto_resolve_timeout    = 1800
+	acknowledgement_timeout = 1800
+	escalation_policy       = pagerduty_escalation_policy.foo.id
+	alert_creation          = "create_alerts_and_incidents"
+	alert_grouping_parameters {
+		type = "time"
+		config {
+			timeout = 1800
+		}
+	}
+}
+`, username, email, escalationPolicy, service)
+}
+
 func testAccCheckPagerDutyServiceConfigWithAlertContentGrouping(username, email, escalationPolicy, service string) string {
 	return fmt.Sprintf(`
 resource "pagerduty_user" "foo" {
@@ -2562,3 +2713,235 @@ resource "pagerduty_service" "foo" {
 }
 `, username, email, escalationPolicy, service, strings.Join(fields, `","`))
 }
+
+func TestResourcePagerDutyServiceAcknowledgementTimeout(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		expected *int
+		state    string
+	}{
+		{
+			name:     "zero",
+			config:   map[string]interface{}{"acknowledgement_timeout": "0"},
+			expected: intPtr(0),
+			state:    "0",
+		},
+		{
+			name:     "null",
+			config:   map[string]interface{}{"acknowledgement_timeout": "null"},
+			expected: nil,
+			state:    "null",
+		},
+		{
+			name:     "omitted",
+			config:   map[string]interface{}{},
+			expected: intPtr(1800),
+			state:    "1800",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.config["name"] = "foo"
+			c.config["escalation_policy"] = "PESCALA"
+			d := schema.TestResourceDataRaw(t, resourcePagerDutyService().Schema, c.config)
+
+			service, err := buildServiceStruct(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := service.AcknowledgementTimeout
+			if (got == nil) != (c.expected == nil) || (got != nil && *got != *c.expected) {
+				t.Fatalf("expected acknowledgement_timeout %v to be sent, got %v", c.expected, got)
+			}
+
+			body, err := json.Marshal(service)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var sent map[string]interface{}
+			if err := json.Unmarshal(body, &sent); err != nil {
+				t.Fatal(err)
+			}
+			if _, ok := sent["acknowledgement_timeout"]; !ok {
+				t.Errorf("expected acknowledgement_timeout to always be sent, got %s", body)
+			}
+
+			service.EscalationPolicy = &pagerduty.EscalationPolicyReference{ID: "PESCALA"}
+			d = schema.TestResourceDataRaw(t, resourcePagerDutyService().Schema, map[string]interface{}{})
+			if err := flattenService(d, service); err != nil {
+				t.Fatal(err)
+			}
+			if v := d.Get("acknowledgement_timeout").(string); v != c.state {
+				t.Errorf("expected acknowledgement_timeout %q in state, got %q", c.state, v)
+			}
+		})
+	}
+}
+
+func TestResourcePagerDutyServiceStateUpgradeV0(t *testing.T) {
+	cases := []struct {
+		name     string
+		rawState map[string]interface{}
+		expected interface{}
+	}{
+		{
+			name: "time alert grouping",
+			rawState: map[string]interface{}{
+				"alert_grouping":         "time",
+				"alert_grouping_timeout": "5",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"type":   "time",
+					"config": []interface{}{map[string]interface{}{"timeout": 5}},
+				},
+			},
+		},
+		{
+			name: "intelligent alert grouping",
+			rawState: map[string]interface{}{
+				"alert_grouping":         "intelligent",
+				"alert_grouping_timeout": "null",
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"type":   "intelligent",
+					"config": []interface{}{map[string]interface{}{}},
+				},
+			},
+		},
+		{
+			name: "alert grouping parameters already set",
+			rawState: map[string]interface{}{
+				"alert_grouping":            "time",
+				"alert_grouping_parameters": []interface{}{map[string]interface{}{"type": "intelligent"}},
+			},
+			expected: []interface{}{map[string]interface{}{"type": "intelligent"}},
+		},
+		{
+			name:     "no alert grouping",
+			rawState: map[string]interface{}{},
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			alertGrouping := c.rawState["alert_grouping"]
+			alertGroupingTimeout := c.rawState["alert_grouping_timeout"]
+
+			actual, err := resourcePagerDutyServiceStateUpgradeV0(context.Background(), c.rawState, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(actual["alert_grouping_parameters"], c.expected) {
+				t.Errorf("expected alert_grouping_parameters %#v, got %#v", c.expected, actual["alert_grouping_parameters"])
+			}
+			if actual["alert_grouping"] != alertGrouping {
+				t.Errorf("expected alert_grouping %#v to be kept in the state, got %#v", alertGrouping, actual["alert_grouping"])
+			}
+			if actual["alert_grouping_timeout"] != alertGroupingTimeout {
+				t.Errorf("expected alert_grouping_timeout %#v to be kept in the state, got %#v", alertGroupingTimeout, actual["alert_grouping_timeout"])
+			}
+		})
+	}
+}
+
+// Test a state stored with the schema at version 0 is decoded with it, and
+// upgraded through the upgrader registered for that version
+func TestResourcePagerDutyServiceStateUpgradeV0FromFrozenSchema(t *testing.T) {
+	upgrader := resourcePagerDutyService().StateUpgraders[0]
+	ty := resourcePagerDutyServiceV0().CoreConfigSchema().ImpliedType()
+	if !upgrader.Type.Equals(ty) {
+		t.Fatalf("expected the upgrader to decode states with the version 0 schema")
+	}
+
+	is := &sdkterraform.InstanceState{
+		ID: "PSERVICE",
+		Attributes: map[string]string{
+			"id":                     "PSERVICE",
+			"name":                   "foo",
+			"escalation_policy":      "PESCALA",
+			"alert_grouping":         "time",
+			"alert_grouping_timeout": "1800",
+		},
+	}
+	val, err := is.AttrsAsObjectValue(upgrader.Type)
+	if err != nil {
+		t.Fatalf("unexpected error decoding the version 0 state: %v", err)
+	}
+	js, err := ctyjson.Marshal(val, upgrader.Type)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var rawState map[string]interface{}
+	if err := json.Unmarshal(js, &rawState); err != nil {
+		t.Fatal(err)
+	}
+
+	actual, err := upgrader.Upgrade(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []interface{}{
+		map[string]interface{}{
+			"type":   "time",
+			"config": []interface{}{map[string]interface{}{"timeout": 1800}},
+		},
+	}
+	if !reflect.DeepEqual(actual["alert_grouping_parameters"], expected) {
+		t.Errorf("expected alert_grouping_parameters %#v, got %#v", expected, actual["alert_grouping_parameters"])
+	}
+	if actual["alert_grouping"] != "time" {
+		t.Errorf("expected alert_grouping to be kept in the state, got %#v", actual["alert_grouping"])
+	}
+}
+
+// Test alert_grouping_parameters is only refreshed when the API returns them,
+// or when the state already tracks them
+func TestResourcePagerDutyServiceFlattenAlertGroupingParameters(t *testing.T) {
+	intelligent := "intelligent"
+	cases := []struct {
+		name     string
+		prior    map[string]interface{}
+		api      *pagerduty.AlertGroupingParameters
+		expected int
+	}{
+		{name: "not returned", api: nil, expected: 0},
+		{name: "returned empty", api: &pagerduty.AlertGroupingParameters{}, expected: 0},
+		{name: "returned", api: &pagerduty.AlertGroupingParameters{Type: &intelligent}, expected: 1},
+		{
+			name: "returned empty while tracked",
+			prior: map[string]interface{}{
+				"alert_grouping_parameters": []interface{}{map[string]interface{}{"type": "intelligent"}},
+			},
+			api:      &pagerduty.AlertGroupingParameters{},
+			expected: 1,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := &pagerduty.Service{
+				Name:                    "foo",
+				EscalationPolicy:        &pagerduty.EscalationPolicyReference{ID: "PESCALA"},
+				AlertGroupingParameters: c.api,
+			}
+			d := schema.TestResourceDataRaw(t, resourcePagerDutyService().Schema, c.prior)
+			if err := flattenService(d, service); err != nil {
+				t.Fatal(err)
+			}
+			agp := d.Get("alert_grouping_parameters").([]interface{})
+			if len(agp) != c.expected {
+				t.Errorf("expected %d alert_grouping_parameters blocks, got %#v", c.expected, agp)
+			}
+			if c.prior != nil && agp[0] != nil {
+				t.Errorf("expected the tracked parameters to be refreshed as empty, got %#v", agp[0])
+			}
+		})
+	}
+}
@@ -1,12 +1,14 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -19,10 +21,10 @@ const (
 
 func resourcePagerDutySlackConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourcePagerDutySlackConnectionCreate,
-		Read:   resourcePagerDutySlackConnectionRead,
-		Update: resourcePagerDutySlackConnectionUpdate,
-		Delete: resourcePagerDutySlackConnectionDelete,
+		Create:      resourcePagerDutySlackConnectionCreate,
+		ReadContext: resourcePagerDutySlackConnectionReadContext,
+		Update:      resourcePagerDutySlackConnectionUpdate,
+		Delete:      resourcePagerDutySlackConnectionDelete,
 		Importer: &schema.ResourceImporter{
 			State: resourcePagerDutySlackConnectionImport,
 		},
@@ -67,13 +69,15 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 			"config": {
 				Type:     schema.TypeList,
 				Required: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"events": {
 							Type:     schema.TypeList,
 							Required: true,
 							Elem: &schema.Schema{
-								Type: schema.TypeString,
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validateValueDiagFunc(slackConnectionEvents),
 							},
 						},
 						"priorities": {
@@ -98,7 +102,30 @@ func resourcePagerDutySlackConnection() *schema.Resource {
 	}
 }
 
-func buildSlackConnectionStruct(d *schema.ResourceData) (*pagerduty.SlackConnection, error) {
+// slackConnectionEvents are the incident events a slack connection can notify.
+var slackConnectionEvents = []string{
+	"incident.triggered",
+	"incident.acknowledged",
+	"incident.escalated",
+	"incident.resolved",
+	"incident.reassigned",
+	"incident.annotated",
+	"incident.unacknowledged",
+	"incident.delegated",
+	"incident.priority_updated",
+	"incident.responder.added",
+	"incident.responder.replied",
+	"incident.status_update_published",
+	"incident.reopened",
+}
+
+func buildSlackConnectionStruct(d *schema.ResourceData, meta interface{}) (*pagerduty.SlackConnection, error) {
+	config := expandConnectionConfig(d.Get("config").(interface{}))
+	var err error
+	if config.Priorities, err = resolvePriorityIDs(meta, config.Priorities); err != nil {
+		return nil, err
+	}
+
 	slackConn := pagerduty.SlackConnection{
 		SourceID:         d.Get("source_id").(string),
 		SourceName:       d.Get("source_name").(string),
@@ -107,7 +134,7 @@ func buildSlackConnectionStruct(d *schema.ResourceData) (*pagerduty.SlackConnect
 		ChannelName:      d.Get("channel_name").(string),
 		WorkspaceID:      d.Get("workspace_id").(string),
 		NotificationType: d.Get("notification_type").(string),
-		Config:           expandConnectionConfig(d.Get("config").(interface{})),
+		Config:           config,
 	}
 	return &slackConn, nil
 }
@@ -118,8 +145,8 @@ func resourcePagerDutySlackConnectionCreate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
-		slackConn, err := buildSlackConnectionStruct(d)
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
+		slackConn, err := buildSlackConnectionStruct(d, meta)
 		if err != nil {
 			return retry.NonRetryableError(err)
 		}
@@ -140,6 +167,29 @@ func resourcePagerDutySlackConnectionCreate(d *schema.ResourceData, meta interfa
 	return resourcePagerDutySlackConnectionRead(d, meta)
 }
 
+func resourcePagerDutySlackConnectionReadContext(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourcePagerDutySlackConnectionRead(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return slackConnectionMissingChannelDiagnostics(d)
+}
+
+// slackConnectionMissingChannelDiagnostics warns about slack connections whose
+// channel name couldn't be resolved, which happens when the channel has been
+// deleted or archived in Slack.
+func slackConnectionMissingChannelDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	if d.Id() == "" || d.Get("channel_name").(string) != "" {
+		return nil
+	}
+	return diag.Diagnostics{
+		{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Slack channel %s of slack connection %s was not found", d.Get("channel_id").(string), d.Id()),
+			Detail:   "PagerDuty didn't resolve the name of the channel, so it may have been deleted or archived in Slack. Notifications of this connection won't be delivered until channel_id points to an existing channel.",
+		},
+	}
+}
+
 func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface{}) error {
 	client, err := meta.(*Config).SlackClient()
 	if err != nil {
@@ -151,7 +201,7 @@ func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface
 	workspaceID := d.Get("workspace_id").(string)
 	log.Printf("[DEBUG] Read Slack Connection: workspace_id %s", workspaceID)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if slackConn, _, err := client.SlackConnections.Get(workspaceID, d.Id()); err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
 				return retry.NonRetryableError(err)
@@ -165,7 +215,14 @@ func resourcePagerDutySlackConnectionRead(d *schema.ResourceData, meta interface
 			d.Set("channel_id", slackConn.ChannelID)
 			d.Set("channel_name", slackConn.ChannelName)
 			d.Set("notification_type", slackConn.NotificationType)
-			d.Set("config", flattenConnectionConfig(slackConn.Config))
+			config := flattenConnectionConfig(slackConn.Config, d.Get("config"))
+			if err := flattenSlackConnectionPriorityNames(config, d.Get("config"), meta); err != nil {
+				if isTransientError(err) {
+					return retry.RetryableError(err)
+				}
+				return retry.NonRetryableError(err)
+			}
+			d.Set("config", config)
 		}
 		return nil
 	})
@@ -184,7 +241,7 @@ func resourcePagerDutySlackConnectionUpdate(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	slackConn, err := buildSlackConnectionStruct(d)
+	slackConn, err := buildSlackConnectionStruct(d, meta)
 	if err != nil {
 		return err
 	}
@@ -207,7 +264,12 @@ func resourcePagerDutySlackConnectionDelete(d *schema.ResourceData, meta interfa
 	workspaceID := d.Get("workspace_id").(string)
 
 	if _, err := client.SlackConnections.Delete(workspaceID, d.Id()); err != nil {
-		return err
+		// A connection removed out-of-band is already gone, which is the
+		// outcome the delete was asked for.
+		if !isErrCode(err, http.StatusNotFound) {
+			return err
+		}
+		log.Printf("[WARN] PagerDuty slack connection %s was already deleted", d.Id())
 	}
 
 	d.SetId("")
@@ -234,6 +296,32 @@ func expandConnectionConfig(v interface{}) pagerduty.ConnectionConfig {
 	return config
 }
 
+// flattenSlackConnectionPriorityNames puts back the priority names used in the
+// prior configuration in place of the priority ids returned by the API.
+func flattenSlackConnectionPriorityNames(config []map[string]interface{}, prior interface{}, meta interface{}) error {
+	l, ok := prior.([]interface{})
+	if !ok || len(l) == 0 || isNilFunc(l[0]) || len(config) == 0 {
+		return nil
+	}
+
+	priorPriorities := expandConfigList(l[0].(map[string]interface{})["priorities"])
+	if !hasPriorityNames(priorPriorities) {
+		return nil
+	}
+
+	var priorities []string
+	if v, ok := config[0]["priorities"].([]interface{}); ok {
+		priorities = expandConfigList(v)
+	}
+	priorities, err := flattenPriorityIDsLike(meta, priorities, priorPriorities)
+	if err != nil {
+		return err
+	}
+	config[0]["priorities"] = flattenConfigList(sortLikeConfigList(priorities, priorPriorities))
+
+	return nil
+}
+
 func expandConfigList(v interface{}) []string {
 	items := []string{}
 	for _, i := range v.([]interface{}) {
@@ -254,11 +342,27 @@ func expandStarWildcardConfig(c []string) []string {
 	return c
 }
 
-func flattenConnectionConfig(config pagerduty.ConnectionConfig) []map[string]interface{} {
+func flattenConnectionConfig(config pagerduty.ConnectionConfig, prior interface{}) []map[string]interface{} {
+	var priorEvents, priorPriorities []string
+	if l, ok := prior.([]interface{}); ok && len(l) > 0 && !isNilFunc(l[0]) {
+		priorConfig := l[0].(map[string]interface{})
+		priorEvents = expandConfigList(priorConfig["events"])
+		if v, ok := priorConfig["priorities"].([]interface{}); ok {
+			priorPriorities = expandConfigList(v)
+		}
+	}
+
+	priorities := flattenStarWildcardConfig(config.Priorities)
+	// A star wildcard may be read back as the ids of all the priorities of the
+	// account, which still means any priority.
+	if isStarWildcard := len(priorPriorities) == 1 && priorPriorities[0] == StarWildcardConfig; isStarWildcard && len(priorities) > 0 {
+		priorities = []string{StarWildcardConfig}
+	}
+
 	var configs []map[string]interface{}
 	configMap := map[string]interface{}{
-		"events":     flattenConfigList(config.Events),
-		"priorities": flattenConfigList(flattenStarWildcardConfig(config.Priorities)),
+		"events":     flattenConfigList(sortLikeConfigList(config.Events, priorEvents)),
+		"priorities": flattenConfigList(priorities),
 	}
 	if config.Urgency != nil {
 		configMap["urgency"] = *config.Urgency
@@ -277,6 +381,37 @@ func flattenConfigList(list []string) interface{} {
 	return items
 }
 
+// sortLikeConfigList orders the items of `list` following their position in
+// `prior`, so an API response returning the same items in a different order
+// than the configured one does not produce a diff. Items missing from `prior`
+// are appended afterwards keeping their original order.
+func sortLikeConfigList(list, prior []string) []string {
+	if len(prior) == 0 {
+		return list
+	}
+
+	pending := make(map[string]int, len(list))
+	for _, i := range list {
+		pending[i]++
+	}
+
+	sorted := make([]string, 0, len(list))
+	for _, i := range prior {
+		if pending[i] > 0 {
+			sorted = append(sorted, i)
+			pending[i]--
+		}
+	}
+	for _, i := range list {
+		if pending[i] > 0 {
+			sorted = append(sorted, i)
+			pending[i]--
+		}
+	}
+
+	return sorted
+}
+
 // Flattens a `nil` configuration to its corresponding star wildcard ("*")
 // configuration value for an attribute which is meant to be accepting this kind
 // of configuration, with the only purpose to match the config stored in the
@@ -1,11 +1,20 @@
 package pagerduty
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
+	"reflect"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -138,6 +147,51 @@ func TestAccPagerDutySlackConnection_NonAndAnyPriorities(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutySlackConnection_PriorityNames(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutySlackConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutySlackConnectionConfigPriorityNames(team, workspaceID, channelID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutySlackConnectionExists("pagerduty_slack_connection.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "config.0.priorities.#", "2"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_slack_connection.foo", "config.0.priorities.0", "P1"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_slack_connection.foo", "config.0.priorities.1", "data.pagerduty_priority.p2", "id"),
+				),
+			},
+			{
+				Config:   testAccCheckPagerDutySlackConnectionConfigPriorityNames(team, workspaceID, channelID),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccPagerDutySlackConnection_MultipleConfigBlocks(t *testing.T) {
+	team := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckPagerDutySlackConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckPagerDutySlackConnectionConfigMultipleConfigBlocks(team, workspaceID, channelID),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`No more than 1 "config" blocks are allowed`),
+			},
+		},
+	})
+}
+
 func testAccCheckPagerDutySlackConnectionDestroy(s *terraform.State) error {
 	config := &pagerduty.Config{
 		Token:   os.Getenv("PAGERDUTY_USER_TOKEN"),
@@ -549,3 +603,374 @@ func testAccCheckPagerDutySlackConnectionConfigNonAndAnyPrioritiesUpdated(userna
   }
   `, username, email, escalationPolicy, service, workspaceID, channelID)
 }
+
+func testAccCheckPagerDutySlackConnectionConfigMultipleConfigBlocks(team, workspaceID, channelID string) string {
+	return fmt.Sprintf(`
+		resource "pagerduty_team" "foo" {
+			name = "%s"
+		}
+		resource "pagerduty_slack_connection" "foo" {
+			source_id = pagerduty_team.foo.id
+			source_type = "team_reference"
+			workspace_id = "%s"
+			channel_id = "%s"
+			notification_type = "responder"
+			config {
+				events = ["incident.triggered"]
+			}
+			config {
+				events = ["incident.resolved"]
+				urgency = "high"
+			}
+		}
+		`, team, workspaceID, channelID)
+}
+
+func testAccCheckPagerDutySlackConnectionConfigPriorityNames(team, workspaceID, channelID string) string {
+	return fmt.Sprintf(`
+		resource "pagerduty_team" "foo" {
+			name = "%s"
+		}
+		data "pagerduty_priority" "p2" {
+			name = "P2"
+		}
+		resource "pagerduty_slack_connection" "foo" {
+			source_id = pagerduty_team.foo.id
+			source_type = "team_reference"
+			workspace_id = "%s"
+			channel_id = "%s"
+			notification_type = "responder"
+			config {
+				events = ["incident.triggered"]
+				priorities = ["P1", data.pagerduty_priority.p2.id]
+			}
+		}
+		`, team, workspaceID, channelID)
+}
+
+func TestResourcePagerDutySlackConnectionValidateEvents(t *testing.T) {
+	config := func(events ...interface{}) *sdkterraform.ResourceConfig {
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"source_id":         "PSERVIC",
+			"source_type":       "service_reference",
+			"workspace_id":      "T0000000",
+			"channel_id":        "C0000000",
+			"notification_type": "responder",
+			"config": []interface{}{
+				map[string]interface{}{"events": events},
+			},
+		})
+	}
+
+	r := resourcePagerDutySlackConnection()
+	if diags := r.Validate(config("incident.triggered", "incident.responder.added")); diags.HasError() {
+		t.Errorf("unexpected error: %v", diags)
+	}
+
+	diags := r.Validate(config("incident.triggered", "incident.created"))
+	if !diags.HasError() {
+		t.Fatalf("expected an error for an unknown event")
+	}
+	if got := attributePathString(diags[0].AttributePath); got != "config.0.events.1" {
+		t.Errorf("expected the error at config.0.events.1, got %q", got)
+	}
+}
+
+func TestResourcePagerDutySlackConnectionValidateSingleConfig(t *testing.T) {
+	block := map[string]interface{}{"events": []interface{}{"incident.triggered"}}
+	config := sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+		"source_id":         "PSERVIC",
+		"source_type":       "service_reference",
+		"workspace_id":      "T0000000",
+		"channel_id":        "C0000000",
+		"notification_type": "responder",
+		"config":            []interface{}{block, block},
+	})
+
+	diags := resourcePagerDutySlackConnection().Validate(config)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for more than one config block")
+	}
+	if got := attributePathString(diags[0].AttributePath); got != "config" {
+		t.Errorf("expected the error at config, got %q", got)
+	}
+}
+
+func TestResourcePagerDutySlackConnectionValidateUrgency(t *testing.T) {
+	config := func(urgency interface{}) *sdkterraform.ResourceConfig {
+		c := map[string]interface{}{"events": []interface{}{"incident.triggered"}}
+		if urgency != nil {
+			c["urgency"] = urgency
+		}
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"source_id":         "PSERVIC",
+			"source_type":       "service_reference",
+			"workspace_id":      "T0000000",
+			"channel_id":        "C0000000",
+			"notification_type": "stakeholder",
+			"config":            []interface{}{c},
+		})
+	}
+
+	r := resourcePagerDutySlackConnection()
+	for _, urgency := range []interface{}{nil, "high", "low"} {
+		if diags := r.Validate(config(urgency)); diags.HasError() {
+			t.Errorf("unexpected error for urgency %v: %v", urgency, diags)
+		}
+	}
+
+	diags := r.Validate(config("medium"))
+	if !diags.HasError() {
+		t.Fatalf("expected an error for urgency medium")
+	}
+	if got := attributePathString(diags[0].AttributePath); got != "config.0.urgency" {
+		t.Errorf("expected the error at config.0.urgency, got %q", got)
+	}
+}
+
+func TestExpandConnectionConfig(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourcePagerDutySlackConnection().Schema, map[string]interface{}{
+		"config": []interface{}{
+			map[string]interface{}{
+				"events":     []interface{}{"incident.triggered", "incident.resolved"},
+				"priorities": []interface{}{"PPRIOR1", "PPRIOR2"},
+				"urgency":    "high",
+			},
+		},
+	})
+
+	config := expandConnectionConfig(d.Get("config"))
+	if want := []string{"incident.triggered", "incident.resolved"}; !reflect.DeepEqual(config.Events, want) {
+		t.Errorf("expected events %v, got %v", want, config.Events)
+	}
+	if want := []string{"PPRIOR1", "PPRIOR2"}; !reflect.DeepEqual(config.Priorities, want) {
+		t.Errorf("expected priorities %v, got %v", want, config.Priorities)
+	}
+	if config.Urgency == nil || *config.Urgency != "high" {
+		t.Errorf("expected urgency high, got %v", config.Urgency)
+	}
+
+	// The star wildcard means any priority, which the API expects as no priorities
+	d = schema.TestResourceDataRaw(t, resourcePagerDutySlackConnection().Schema, map[string]interface{}{
+		"config": []interface{}{
+			map[string]interface{}{
+				"events":     []interface{}{"incident.triggered"},
+				"priorities": []interface{}{StarWildcardConfig},
+			},
+		},
+	})
+
+	config = expandConnectionConfig(d.Get("config"))
+	if config.Priorities != nil {
+		t.Errorf("expected no priorities, got %v", config.Priorities)
+	}
+	if config.Urgency != nil {
+		t.Errorf("expected no urgency, got %v", *config.Urgency)
+	}
+}
+
+func TestFlattenConnectionConfig_StarWildcardPriorities(t *testing.T) {
+	priorWith := func(priorities ...interface{}) interface{} {
+		return []interface{}{
+			map[string]interface{}{"events": []interface{}{"incident.triggered"}, "priorities": priorities},
+		}
+	}
+
+	cases := []struct {
+		name     string
+		prior    interface{}
+		api      []string
+		expected []interface{}
+	}{
+		{
+			name:     "no priorities without prior state",
+			prior:    nil,
+			api:      nil,
+			expected: []interface{}{StarWildcardConfig},
+		},
+		{
+			name:     "no priority",
+			prior:    priorWith(),
+			api:      []string{},
+			expected: nil,
+		},
+		{
+			name:     "star wildcard read back as no priorities",
+			prior:    priorWith(StarWildcardConfig),
+			api:      nil,
+			expected: []interface{}{StarWildcardConfig},
+		},
+		{
+			name:     "star wildcard read back as priority ids",
+			prior:    priorWith(StarWildcardConfig),
+			api:      []string{"PPRIOR1", "PPRIOR2"},
+			expected: []interface{}{StarWildcardConfig},
+		},
+		{
+			name:     "explicit priority ids",
+			prior:    priorWith("PPRIOR1"),
+			api:      []string{"PPRIOR1", "PPRIOR2"},
+			expected: []interface{}{"PPRIOR1", "PPRIOR2"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			apiConfig := pagerduty.ConnectionConfig{Events: []string{"incident.triggered"}, Priorities: c.api}
+			got := flattenConnectionConfig(apiConfig, c.prior)[0]["priorities"]
+			if !reflect.DeepEqual(got, c.expected) {
+				t.Errorf("expected priorities %v, got %v", c.expected, got)
+			}
+		})
+	}
+}
+
+func TestFlattenConnectionConfig_EventsOrder(t *testing.T) {
+	prior := []interface{}{
+		map[string]interface{}{
+			"events": []interface{}{"incident.triggered", "incident.acknowledged", "incident.resolved"},
+		},
+	}
+	apiConfig := pagerduty.ConnectionConfig{
+		Events: []string{"incident.resolved", "incident.triggered", "incident.acknowledged"},
+	}
+
+	got := flattenConnectionConfig(apiConfig, prior)[0]["events"]
+	want := []interface{}{"incident.triggered", "incident.acknowledged", "incident.resolved"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected events %v, got %v", want, got)
+	}
+
+	// Events added out-of-band must still be reported
+	apiConfig.Events = append(apiConfig.Events, "incident.reopened")
+	got = flattenConnectionConfig(apiConfig, prior)[0]["events"]
+	want = append(want, "incident.reopened")
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected events %v, got %v", want, got)
+	}
+
+	// Without prior state the API order is kept
+	got = flattenConnectionConfig(apiConfig, nil)[0]["events"]
+	want = []interface{}{"incident.resolved", "incident.triggered", "incident.acknowledged", "incident.reopened"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected events %v, got %v", want, got)
+	}
+}
+
+func TestResourcePagerDutySlackConnectionReadMissingChannel(t *testing.T) {
+	channelName := ""
+	config := newTestConfig(t, &Config{
+		UserToken: "bar",
+		RetryTime: time.Second,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"slack_connection": {
+			"id": "A12BCDE", "source_id": "PSERVIC", "source_type": "service_reference",
+			"channel_id": "C02CABCDAC9", "channel_name": %q, "notification_type": "responder",
+			"config": {"events": ["incident.triggered"]}
+		}}`, channelName)
+	})
+	read := func() diag.Diagnostics {
+		d := schema.TestResourceDataRaw(t, resourcePagerDutySlackConnection().Schema, map[string]interface{}{
+			"workspace_id": "T02A123LV1A",
+		})
+		d.SetId("A12BCDE")
+		return resourcePagerDutySlackConnectionReadContext(context.Background(), d, config)
+	}
+
+	diags := read()
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "C02CABCDAC9") {
+		t.Errorf("expected a warning about the missing channel, got %v", diags)
+	}
+
+	channelName = "general"
+	if diags := read(); len(diags) != 0 {
+		t.Errorf("expected no diagnostics for an existing channel, got %v", diags)
+	}
+}
+
+// Test a slack connection created but failing to be read right after is kept
+// in the state, instead of being orphaned in PagerDuty.
+func TestResourcePagerDutySlackConnectionCreateReadFailure(t *testing.T) {
+	config := newTestConfig(t, &Config{
+		UserToken: "bar",
+		RetryTime: time.Second,
+	}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"slack_connection": {"id": "A12BCDE", "workspace_id": "T02A123LV1A"}}`)
+			return
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourcePagerDutySlackConnection().Schema, map[string]interface{}{
+		"source_id":         "PSERVIC",
+		"source_type":       "service_reference",
+		"workspace_id":      "T02A123LV1A",
+		"channel_id":        "C02CABCDAC9",
+		"notification_type": "responder",
+		"config": []interface{}{
+			map[string]interface{}{
+				"events": []interface{}{"incident.triggered"},
+			},
+		},
+	})
+
+	if err := resourcePagerDutySlackConnectionCreate(d, config); err == nil {
+		t.Fatalf("expected the create to fail reading the slack connection")
+	}
+	if d.Id() != "A12BCDE" {
+		t.Errorf("expected the created slack connection id to be kept, got %q", d.Id())
+	}
+}
+
+func TestResourcePagerDutySlackConnectionDeleteAlreadyDeleted(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "removed out-of-band", status: http.StatusNotFound},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			config := newTestConfig(t, &Config{
+				UserToken: "bar",
+				RetryTime: time.Second,
+			}, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.status)
+				fmt.Fprintf(w, `{"error": {"code": 2100, "message": "%s"}}`, http.StatusText(tc.status))
+			})
+
+			d := resourcePagerDutySlackConnection().Data(nil)
+			d.SetId("A12BCDE")
+			d.Set("workspace_id", "T02A123LV1A")
+
+			err := resourcePagerDutySlackConnectionDelete(d, config)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected the delete to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error deleting an already deleted connection, got %v", err)
+			}
+			if d.Id() != "" {
+				t.Errorf("expected the slack connection to be removed from state, got %q", d.Id())
+			}
+		})
+	}
+}
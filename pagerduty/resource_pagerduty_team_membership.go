@@ -88,7 +88,7 @@ func fetchPagerDutyTeamMembership(d *schema.ResourceData, meta interface{}, errC
 	}
 
 	log.Printf("[DEBUG] Reading user: %s from team: %s", userID, teamID)
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := client.Teams.GetMembers(teamID, &pagerduty.GetMembersOptions{})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -133,7 +133,7 @@ func resourcePagerDutyTeamMembershipCreate(d *schema.ResourceData, meta interfac
 
 	log.Printf("[DEBUG] Adding user: %s to team: %s with role: %s", userID, teamID, role)
 
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Teams.AddUserWithRole(teamID, userID, role); err != nil {
 			if isErrCode(err, 500) {
 				return retry.RetryableError(err)
@@ -170,7 +170,7 @@ func resourcePagerDutyTeamMembershipUpdate(d *schema.ResourceData, meta interfac
 	log.Printf("[DEBUG] Updating user: %s to team: %s with role: %s", userID, teamID, role)
 
 	// To update existing membership resource, We can use the same API as creating a new membership.
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Teams.AddUserWithRole(teamID, userID, role); err != nil {
 			if isErrCode(err, 500) {
 				return retry.RetryableError(err)
@@ -204,18 +204,18 @@ func resourcePagerDutyTeamMembershipDelete(d *schema.ResourceData, meta interfac
 	log.Printf("[DEBUG] Removing user: %s from team: %s", userID, teamID)
 
 	// Extracting Escalation Policies ids where this team referenced
-	epsAssociatedToUser, err := extractEPsAssociatedToUser(client, userID)
+	epsAssociatedToUser, err := extractEPsAssociatedToUser(client, meta, userID)
 	if err != nil {
 		return err
 	}
 
-	epsDissociatedFromTeam, err := dissociateEPsFromTeam(client, teamID, epsAssociatedToUser)
+	epsDissociatedFromTeam, err := dissociateEPsFromTeam(client, meta, teamID, epsAssociatedToUser)
 	if err != nil {
 		return err
 	}
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Teams.RemoveUser(teamID, userID); err != nil {
 			if isErrCode(err, 400) {
 				return retry.RetryableError(err)
@@ -232,7 +232,7 @@ func resourcePagerDutyTeamMembershipDelete(d *schema.ResourceData, meta interfac
 
 	d.SetId("")
 
-	err = associateEPsBackToTeam(client, teamID, epsDissociatedFromTeam)
+	err = associateEPsBackToTeam(client, meta, teamID, epsDissociatedFromTeam)
 	if err != nil {
 		return err
 	}
@@ -250,9 +250,9 @@ func buildEPsIdsList(l []*pagerduty.OnCall) []string {
 	return unique(eps)
 }
 
-func extractEPsAssociatedToUser(c *pagerduty.Client, userID string) ([]string, error) {
+func extractEPsAssociatedToUser(c *pagerduty.Client, meta interface{}, userID string) ([]string, error) {
 	var oncalls []*pagerduty.OnCall
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := c.OnCall.List(&pagerduty.ListOnCallOptions{UserIds: []string{userID}})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -272,10 +272,10 @@ func extractEPsAssociatedToUser(c *pagerduty.Client, userID string) ([]string, e
 	return epsAssociatedToUser, nil
 }
 
-func dissociateEPsFromTeam(c *pagerduty.Client, teamID string, eps []string) ([]string, error) {
+func dissociateEPsFromTeam(c *pagerduty.Client, meta interface{}, teamID string, eps []string) ([]string, error) {
 	epsDissociatedFromTeam := []string{}
 	for _, ep := range eps {
-		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 			_, err := c.Teams.RemoveEscalationPolicy(teamID, ep)
 			if err != nil && !isErrCode(err, 404) {
 				time.Sleep(2 * time.Second)
@@ -301,9 +301,9 @@ func dissociateEPsFromTeam(c *pagerduty.Client, teamID string, eps []string) ([]
 	return epsDissociatedFromTeam, nil
 }
 
-func associateEPsBackToTeam(c *pagerduty.Client, teamID string, eps []string) error {
+func associateEPsBackToTeam(c *pagerduty.Client, meta interface{}, teamID string, eps []string) error {
 	for _, ep := range eps {
-		retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+		retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 			_, err := c.Teams.AddEscalationPolicy(teamID, ep)
 			if err != nil && !isErrCode(err, 404) {
 				time.Sleep(2 * time.Second)
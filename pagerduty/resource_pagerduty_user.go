@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/heimweh/go-pagerduty/pagerduty"
@@ -37,30 +38,23 @@ func resourcePagerDutyUser() *schema.Resource {
 			},
 
 			"color": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validate.ColorDiagFunc,
 			},
 
 			"role": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Default:  "user",
-				ValidateDiagFunc: validateValueDiagFunc([]string{
-					"admin",
-					"limited_user",
-					"observer",
-					"owner",
-					"read_only_user",
-					"restricted_access",
-					"read_only_limited_user",
-					"user",
-				}),
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "user",
+				ValidateDiagFunc: validate.RoleDiagFunc,
 			},
 
 			"job_title": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validate.MaxLengthDiagFunc(100),
 			},
 
 			"avatar_url": {
@@ -177,7 +171,7 @@ func resourcePagerDutyUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[INFO] pooh Reading PagerDuty user %s", d.Id())
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		user, err := client.Users.GetWithLicense(d.Id(), &pagerduty.GetUserOptions{})
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
@@ -233,7 +227,7 @@ func resourcePagerDutyUserUpdate(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Updating PagerDuty user %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, _, err := client.Users.Update(d.Id(), user); err != nil {
 			if isErrCode(err, 400) {
 				return retry.RetryableError(err)
@@ -300,7 +294,7 @@ func resourcePagerDutyUserDelete(d *schema.ResourceData, meta interface{}) error
 	log.Printf("[INFO] Deleting PagerDuty user %s", d.Id())
 
 	// Retrying to give other resources (such as escalation policies) to delete
-	retryErr := retry.Retry(2*time.Minute, func() *retry.RetryError {
+	retryErr := retry.Retry(retryTime(meta), func() *retry.RetryError {
 		if _, err := client.Users.Delete(d.Id()); err != nil {
 			if isErrCode(err, 400) {
 				return retry.RetryableError(err)
@@ -80,7 +80,7 @@ func fetchPagerDutyUserNotificationRule(d *schema.ResourceData, meta interface{}
 
 	userID := d.Get("user_id").(string)
 
-	return retry.Retry(2*time.Minute, func() *retry.RetryError {
+	return retry.Retry(retryTime(meta), func() *retry.RetryError {
 		resp, _, err := client.Users.GetNotificationRule(userID, d.Id())
 		if err != nil {
 			if isErrCode(err, http.StatusBadRequest) {
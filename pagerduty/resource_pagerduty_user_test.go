@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	sdkterraform "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -385,3 +386,26 @@ resource "pagerduty_user" "foo" {
 }
 `, team1, team2, username, email)
 }
+
+func TestResourcePagerDutyUserValidateJobTitle(t *testing.T) {
+	r := resourcePagerDutyUser()
+	config := func(jobTitle string) *sdkterraform.ResourceConfig {
+		return sdkterraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":      "Earline Greenholt",
+			"email":     "earline@foo.test",
+			"job_title": jobTitle,
+		})
+	}
+
+	if diags := r.Validate(config(strings.Repeat("a", 100))); diags.HasError() {
+		t.Errorf("expected a 100 characters job_title to be valid, got: %v", diags)
+	}
+
+	diags := r.Validate(config(strings.Repeat("a", 101)))
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a job_title over 100 characters")
+	}
+	if got := attributePathString(diags[0].AttributePath); got != "job_title" {
+		t.Errorf("expected the error on job_title, got %s", got)
+	}
+}
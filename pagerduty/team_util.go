@@ -0,0 +1,125 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/heimweh/go-pagerduty/pagerduty"
+)
+
+// pagerDutyIDRegexp matches the shape of the opaque ids PagerDuty assigns to
+// its objects, e.g. "PXXXXXX".
+var pagerDutyIDRegexp = regexp.MustCompile(`^P[A-Z0-9]{6,}$`)
+
+// isPagerDutyID reports whether a value has the shape of a PagerDuty id. Names
+// can have that shape too, so it is only a hint of what needs to be looked up
+// first.
+func isPagerDutyID(v string) bool {
+	return pagerDutyIDRegexp.MatchString(v)
+}
+
+// resolveTeamID returns the id of the team referenced by `v`, which can be
+// either a team id or a team name. Resolved values are cached in the provider
+// configuration, so each of them is requested only once.
+func resolveTeamID(meta interface{}, v string) (string, error) {
+	if v == "" {
+		return v, nil
+	}
+
+	config := meta.(*Config)
+
+	config.lookupMu.Lock()
+	id, ok := config.teamIDs[v]
+	config.lookupMu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	client, err := config.Client()
+	if err != nil {
+		return "", err
+	}
+
+	id, err = fetchTeamID(client, meta, v)
+	if err != nil {
+		return "", err
+	}
+
+	config.lookupMu.Lock()
+	if config.teamIDs == nil {
+		config.teamIDs = make(map[string]string)
+	}
+	config.teamIDs[v] = id
+	config.lookupMu.Unlock()
+
+	return id, nil
+}
+
+// fetchTeamID looks up the team referenced by `v`. A value with the shape of
+// an id is first confirmed to be a team id, falling back to a lookup by name
+// when no team has that id.
+func fetchTeamID(client *pagerduty.Client, meta interface{}, v string) (string, error) {
+	if isPagerDutyID(v) {
+		var found bool
+		err := retry.Retry(retryTime(meta), func() *retry.RetryError {
+			_, _, err := client.Teams.Get(v)
+			if err != nil {
+				if isErrCode(err, http.StatusNotFound) {
+					return nil
+				}
+				if isErrCode(err, http.StatusBadRequest) {
+					return retry.NonRetryableError(err)
+				}
+
+				time.Sleep(2 * time.Second)
+				return retry.RetryableError(err)
+			}
+			found = true
+			return nil
+		})
+		if err != nil {
+			return "", err
+		}
+		if found {
+			return v, nil
+		}
+	}
+
+	var found *pagerduty.Team
+	o := &pagerduty.ListTeamsOptions{Query: v, Limit: apiutil.Limit}
+
+	opts := apiutil.AllOptions{
+		Timeout:        retryTime(meta),
+		Delay:          2 * time.Second,
+		IsNonRetryable: isBadRequestErr,
+	}
+	err := apiutil.AllPages(context.Background(), opts, func(offset int) (int, bool, error) {
+		o.Offset = offset
+		resp, _, err := client.Teams.List(o)
+		if err != nil {
+			return 0, false, err
+		}
+
+		for _, team := range resp.Teams {
+			if team.Name == v {
+				found = team
+				return len(resp.Teams), false, nil
+			}
+		}
+		return len(resp.Teams), resp.More, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if found == nil {
+		return "", fmt.Errorf("Unable to locate any team with name: %s", v)
+	}
+
+	return found.ID, nil
+}
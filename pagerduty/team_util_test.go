@@ -0,0 +1,70 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestResolveTeamID(t *testing.T) {
+	var requests []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.URL.String())
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/teams/PTEAMID":
+			fmt.Fprint(w, `{"team": {"id": "PTEAMID", "name": "Foo"}}`)
+		case r.URL.Path == "/teams/PLATFORM":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+		case r.URL.Path == "/teams" && r.URL.Query().Get("offset") == "":
+			fmt.Fprint(w, `{"teams": [{"id": "PFIRST1", "name": "PLATFORM Ops"}], "more": true, "limit": 1}`)
+		case r.URL.Path == "/teams" && r.URL.Query().Get("offset") == "1":
+			fmt.Fprint(w, `{"teams": [{"id": "PSECOND", "name": "PLATFORM"}], "more": false, "limit": 1}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+		}
+	}
+	config := newTestConfig(t, &Config{}, handler)
+
+	cases := []struct {
+		given string
+		want  string
+	}{
+		{given: "PTEAMID", want: "PTEAMID"},
+		// A team name with the shape of an id, found on the second page
+		{given: "PLATFORM", want: "PSECOND"},
+	}
+
+	for _, c := range cases {
+		got, err := resolveTeamID(config, c.given)
+		if err != nil {
+			t.Fatalf("unexpected error resolving %q: %v", c.given, err)
+		}
+		if got != c.want {
+			t.Errorf("expected %q to resolve to %q, got %q", c.given, c.want, got)
+		}
+	}
+
+	n := len(requests)
+	if _, err := resolveTeamID(config, "PLATFORM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) != n {
+		t.Errorf("expected the resolved team to be cached, got requests: %v", requests[n:])
+	}
+
+	other := newTestConfig(t, &Config{}, handler)
+	if _, err := resolveTeamID(other, "PLATFORM"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(requests) == n {
+		t.Errorf("expected the cache not to be shared between provider configurations")
+	}
+
+	if _, err := resolveTeamID(config, "Unknown"); err == nil {
+		t.Errorf("expected an error for an unknown team name")
+	}
+}
@@ -27,7 +27,9 @@ type Config struct {
 	// Override default PagerDuty API URL
 	APIURLOverride string
 
-	// The PagerDuty APP URL
+	// The PagerDuty APP URL of the service region, for the endpoints only the
+	// web app serves, e.g. the Slack integration ones. `html_url` values come
+	// from the API already pointing to the account's domain and are kept as is.
 	AppURL string
 
 	// The PagerDuty API V2 token
@@ -36,6 +38,9 @@ type Config struct {
 	// The PagerDuty User level token for Slack
 	UserToken string
 
+	// Email of the PagerDuty user sent in the `From` header of the requests
+	UserEmail string
+
 	// Skip validation of the token against the PagerDuty API
 	SkipCredsValidation bool
 
@@ -48,17 +53,53 @@ type Config struct {
 	// Do not verify TLS certs for HTTPS requests - useful if you're behind a corporate proxy
 	InsecureTls bool
 
+	// Hostnames for which TLS certs are not verified on HTTPS requests
+	InsecureTlsHosts []string
+
 	// Parameters for fine-grained access control
 	AppOauthScopedToken *AppOauthScopedToken
 
+	// Maximum time to keep retrying a request to the PagerDuty API
+	RetryTime time.Duration
+
+	// Maximum time to keep retrying a long-running request to the PagerDuty API
+	RetryTimeLong time.Duration
+
+	// Maximum time a single request to the PagerDuty API can take, retries
+	// aside
+	RequestTimeout time.Duration
+
 	// API wrapper
 	client *pagerduty.Client
+
+	// API wrapper for the Slack integration, authenticated with the user token
+	slackClient *pagerduty.Client
 }
 
 type AppOauthScopedToken struct {
 	ClientID, ClientSecret, Subdomain string
 }
 
+// Default maximum amount of time resources keep retrying a request to the
+// PagerDuty API before giving up, when not set in the provider configuration.
+const (
+	defaultRetryTime     = 2 * time.Minute
+	defaultRetryTimeLong = 5 * time.Minute
+)
+
+// Default maximum amount of time a single request to the PagerDuty API can
+// take, when not set in the provider configuration. It is kept well below
+// defaultRetryTime so a hanging request still leaves room to retry.
+const defaultRequestTimeout = 1 * time.Minute
+
+// ProviderData is what the provider hands to its resources and data sources
+// once configured: the PagerDuty client, and the configuration it was created
+// from for the settings of the provider, e.g. its retry timeouts.
+type ProviderData struct {
+	Client *pagerduty.Client
+	Config *Config
+}
+
 const invalidCreds = `
 No valid credentials found for PagerDuty provider.
 Please see https://www.terraform.io/docs/providers/pagerduty/index.html
@@ -75,28 +116,29 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 		return c.client, nil
 	}
 
-	httpClient := http.DefaultClient
-	httpClient.Timeout = 1 * time.Minute
+	httpClient := &http.Client{Timeout: c.requestTimeout()}
 
 	transport := http.DefaultTransport.(*http.Transport).Clone()
 	if c.InsecureTls {
 		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	} else if len(c.InsecureTlsHosts) > 0 {
+		transport.TLSClientConfig = util.InsecureHostsTLSConfig(c.InsecureTlsHosts)
 	}
-	httpClient.Transport = logging.NewTransport("PagerDuty", transport)
+	httpClient.Transport = logging.NewTransport("PagerDuty", &util.FromHeaderTransport{Email: c.UserEmail, Next: transport})
 
-	apiURL := c.APIURL
-	if c.APIURLOverride != "" {
-		apiURL = c.APIURLOverride
-	}
+	apiURL := c.apiURL()
 
+	// The client waits an exponential backoff of 2^attempt seconds before
+	// retrying network errors, rate limits and server errors, capped at
+	// maxRetryDelay.
 	maxRetries := 1
-	retryInterval := 60 // seconds
+	maxRetryDelay := 60 // seconds
 
 	clientOpts := []pagerduty.ClientOptions{
 		WithHTTPClient(httpClient),
 		pagerduty.WithAPIEndpoint(apiURL),
 		pagerduty.WithTerraformProvider(c.TerraformVersion),
-		pagerduty.WithRetryPolicy(maxRetries, retryInterval),
+		pagerduty.WithRetryPolicy(maxRetries, maxRetryDelay),
 	}
 
 	if c.AppOauthScopedToken != nil {
@@ -133,6 +175,93 @@ func (c *Config) Client(ctx context.Context) (*pagerduty.Client, error) {
 	return c.client, nil
 }
 
+// apiURL returns the URL of the PagerDuty API the client sends requests to.
+func (c *Config) apiURL() string {
+	if c.APIURLOverride != "" {
+		return c.APIURLOverride
+	}
+	return c.APIURL
+}
+
+// SlackClient returns a PagerDuty client for the Slack integration endpoints,
+// which are served from the PagerDuty APP URL and require the user level
+// token, initializing when necessary.
+func (c *Config) SlackClient() (*pagerduty.Client, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Return the previously-configured client if available.
+	if c.slackClient != nil {
+		return c.slackClient, nil
+	}
+
+	// Validate that the user level PagerDuty token is set
+	if c.UserToken == "" {
+		return nil, fmt.Errorf(invalidCreds)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if c.InsecureTls {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	} else if len(c.InsecureTlsHosts) > 0 {
+		transport.TLSClientConfig = util.InsecureHostsTLSConfig(c.InsecureTlsHosts)
+	}
+	httpClient := &http.Client{
+		Timeout:   c.requestTimeout(),
+		Transport: logging.NewTransport("PagerDuty", transport),
+	}
+
+	c.slackClient = pagerduty.NewClient(c.UserToken,
+		WithHTTPClient(httpClient),
+		pagerduty.WithTerraformProvider(c.TerraformVersion),
+	)
+
+	log.Printf("[INFO] PagerDuty plugin client configured for slack")
+	return c.slackClient, nil
+}
+
+const missingUserEmail = `
+A PagerDuty user email is required to send in the "From" header of the
+request. Please set the "user_email" argument of the provider or the
+PAGERDUTY_USER_EMAIL environment variable.
+`
+
+// userEmail returns the email of the PagerDuty user set in the provider
+// configuration, for requests requiring a `From` header.
+func (c *Config) userEmail() (string, error) {
+	if c.UserEmail == "" {
+		return "", fmt.Errorf(missingUserEmail)
+	}
+	return c.UserEmail, nil
+}
+
+// requestTimeout returns the maximum amount of time a single request to the
+// PagerDuty API can take.
+func (c *Config) requestTimeout() time.Duration {
+	if c.RequestTimeout > 0 {
+		return c.RequestTimeout
+	}
+	return defaultRequestTimeout
+}
+
+// retryTime returns the maximum amount of time to keep retrying a request to
+// the PagerDuty API.
+func (c *Config) retryTime() time.Duration {
+	if c.RetryTime > 0 {
+		return c.RetryTime
+	}
+	return defaultRetryTime
+}
+
+// retryTimeLong returns the maximum amount of time to keep retrying a
+// long-running request to the PagerDuty API.
+func (c *Config) retryTimeLong() time.Duration {
+	if c.RetryTimeLong > 0 {
+		return c.RetryTimeLong
+	}
+	return defaultRetryTimeLong
+}
+
 func WithHTTPClient(httpClient pagerduty.HTTPClient) pagerduty.ClientOptions {
 	return func(c *pagerduty.Client) {
 		if util.IsNilFunc(httpClient) {
@@ -217,29 +346,58 @@ func availableOauthScopes() []string {
 // the property of any datasource or resource struct from the general
 // configuration of the provider.
 func ConfigurePagerdutyClient(dst **pagerduty.Client, providerData any) diag.Diagnostics {
-	var diags diag.Diagnostics
-	if providerData == nil {
+	data, diags := providerDataFrom(providerData)
+	if data == nil || diags.HasError() {
 		return diags
 	}
-	client, ok := providerData.(*pagerduty.Client)
-	if !ok {
+	if dst == nil {
 		diags.AddError(
-			"Unexpected Data Source Configure Type",
-			fmt.Sprintf(
-				"Expected *github.com/PagerDuty/go-pagerduty.Client, got: %T."+
-					"Please report this issue to the provider developers.",
-				providerData,
-			),
+			"Bad usage of ConfigurePagerdutyClient",
+			"Received a null client destination",
 		)
 		return diags
 	}
+	*dst = data.Client
+	return diags
+}
+
+// ConfigurePagerdutyConfig sets the configuration of the provider in a
+// pointer `dst` to the property of any datasource or resource struct that
+// depends on its settings, e.g. its retry timeouts.
+func ConfigurePagerdutyConfig(dst **Config, providerData any) diag.Diagnostics {
+	data, diags := providerDataFrom(providerData)
+	if data == nil || diags.HasError() {
+		return diags
+	}
 	if dst == nil {
 		diags.AddError(
-			"Bad usage of ConfigurePagerdutyClient",
-			"Received a null client destination",
+			"Bad usage of ConfigurePagerdutyConfig",
+			"Received a null configuration destination",
 		)
 		return diags
 	}
-	*dst = client
+	*dst = data.Config
 	return diags
 }
+
+// providerDataFrom returns the ProviderData handed by the provider, nil when
+// it is yet to be configured.
+func providerDataFrom(providerData any) (*ProviderData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if providerData == nil {
+		return nil, diags
+	}
+	data, ok := providerData.(*ProviderData)
+	if !ok {
+		diags.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf(
+				"Expected *ProviderData, got: %T."+
+					"Please report this issue to the provider developers.",
+				providerData,
+			),
+		)
+		return nil, diags
+	}
+	return data, diags
+}
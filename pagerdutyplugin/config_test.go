@@ -2,7 +2,12 @@ package pagerduty
 
 import (
 	"context"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 // Test config with an empty token
@@ -79,3 +84,116 @@ func TestConfigInsecureTls(t *testing.T) {
 		t.Fatalf("error: expected the client to not fail: %v", err)
 	}
 }
+
+// Test config with InsecureTlsHosts
+func TestConfigInsecureTlsHosts(t *testing.T) {
+	config := Config{
+		Token:               "foo",
+		InsecureTlsHosts:    []string{"proxy.example.internal"},
+		SkipCredsValidation: true,
+	}
+
+	if _, err := config.Client(context.Background()); err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+}
+
+// Test retry timeouts are taken from the configuration, or their defaults
+// when not set
+func TestConfigRetryTimeouts(t *testing.T) {
+	defaults := &Config{}
+	custom := &Config{
+		RetryTime:     30 * time.Second,
+		RetryTimeLong: 90 * time.Second,
+	}
+
+	if got := defaults.retryTime(); got != defaultRetryTime {
+		t.Errorf("expected retry time to be %v, got %v", defaultRetryTime, got)
+	}
+	if got := defaults.retryTimeLong(); got != defaultRetryTimeLong {
+		t.Errorf("expected long retry time to be %v, got %v", defaultRetryTimeLong, got)
+	}
+	if got := custom.retryTime(); got != 30*time.Second {
+		t.Errorf("expected retry time to be %v, got %v", 30*time.Second, got)
+	}
+	if got := custom.retryTimeLong(); got != 90*time.Second {
+		t.Errorf("expected long retry time to be %v, got %v", 90*time.Second, got)
+	}
+}
+
+// Test resources stop retrying once the configured retry timeout is reached
+func TestConfigRetryTimeoutIsUsed(t *testing.T) {
+	var requests int32
+	config := &Config{RetryTimeLong: time.Second}
+	client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	var diags diag.Diagnostics
+	start := time.Now()
+	requestGetAddon(context.Background(), client, config, "PXXXXXX", nil, &diags)
+	elapsed := time.Since(start)
+
+	if !diags.HasError() {
+		t.Fatalf("expected the request to fail")
+	}
+	if elapsed > 30*time.Second {
+		t.Errorf("expected the request to give up after the configured retry timeout, took %v", elapsed)
+	}
+	if atomic.LoadInt32(&requests) == 0 {
+		t.Errorf("expected at least one request to the API")
+	}
+}
+
+// Test a hanging request is cut at the request timeout and retried until the
+// retry timeout is reached
+func TestConfigRequestTimeoutIsUsed(t *testing.T) {
+	var requests int32
+	config := &Config{
+		RetryTimeLong:  3 * time.Second,
+		RequestTimeout: 200 * time.Millisecond,
+	}
+	client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-r.Context().Done()
+	})
+
+	var diags diag.Diagnostics
+	start := time.Now()
+	requestGetAddon(context.Background(), client, config, "PXXXXXX", nil, &diags)
+	elapsed := time.Since(start)
+
+	if !diags.HasError() {
+		t.Fatalf("expected the request to fail")
+	}
+	if elapsed > 30*time.Second {
+		t.Errorf("expected the request to give up after the configured retry timeout, took %v", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got < 2 {
+		t.Errorf("expected the timed out request to be retried, got %d requests", got)
+	}
+}
+
+// Test the client retries server errors once with the configured policy
+func TestConfigRetryPolicyIsApplied(t *testing.T) {
+	var requests int32
+	client := newTestClient(t, &Config{}, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	start := time.Now()
+	if _, err := client.GetTagWithContext(context.Background(), "PXXXXXX"); err == nil {
+		t.Fatalf("expected the request to fail")
+	}
+	elapsed := time.Since(start)
+
+	if got := atomic.LoadInt32(&requests); got != 2 {
+		t.Errorf("expected the request to be retried once, got %d requests", got)
+	}
+	// The first retry waits 2^0 seconds
+	if elapsed < time.Second {
+		t.Errorf("expected the retry to wait for the backoff, took %v", elapsed)
+	}
+}
@@ -0,0 +1,231 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// accountExportDefaultMaxEntities bounds the entities listed when
+// `max_entities` isn't set, so a large account doesn't blow up the state.
+const accountExportDefaultMaxEntities = 1000
+
+type dataSourceAccountExport struct {
+	client *pagerduty.Client
+}
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceAccountExport)(nil)
+
+func (d *dataSourceAccountExport) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_account_export"
+}
+
+func (d *dataSourceAccountExport) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resourceTypes := make([]string, 0, len(accountExportListers))
+	for t := range accountExportListers {
+		resourceTypes = append(resourceTypes, t)
+	}
+	sort.Strings(resourceTypes)
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.StringAttribute{
+				Required:    true,
+				Description: "The type of the Terraform resource whose existing entities are listed",
+				Validators:  []validator.String{stringvalidator.OneOf(resourceTypes...)},
+			},
+			"max_entities": schema.Int64Attribute{
+				Optional:    true,
+				Description: fmt.Sprintf("The maximum number of entities to list, defaults to %d", accountExportDefaultMaxEntities),
+				Validators:  []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"truncated": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether more entities exist than the ones listed",
+			},
+			"entities": schema.ListAttribute{
+				ElementType: accountExportEntityObjectType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceAccountExport) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+}
+
+func (d *dataSourceAccountExport) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	log.Printf("[INFO] Reading PagerDuty account export")
+
+	var data dataSourceAccountExportModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxEntities := accountExportDefaultMaxEntities
+	if !data.MaxEntities.IsNull() && !data.MaxEntities.IsUnknown() {
+		maxEntities = int(data.MaxEntities.ValueInt64())
+	}
+
+	resourceType := data.ResourceType.ValueString()
+	entities, truncated, err := requestListAccountExportEntities(ctx, d.client, resourceType, maxEntities)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error listing %s entities", resourceType), err.Error())
+		return
+	}
+
+	list, diags := flattenAccountExportEntities(ctx, entities)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Entities = list
+	data.Truncated = types.BoolValue(truncated)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// accountExportEntity holds the attributes of an entity needed to write its
+// import block.
+type accountExportEntity struct {
+	ID          string
+	Name        string
+	Description string
+	HTMLURL     string
+}
+
+// accountExportListFunc lists a page of entities starting at `offset`, and
+// reports whether there are more.
+type accountExportListFunc = func(ctx context.Context, client *pagerduty.Client, offset int) ([]accountExportEntity, bool, error)
+
+var accountExportListers = map[string]accountExportListFunc{
+	"pagerduty_escalation_policy": func(ctx context.Context, client *pagerduty.Client, offset int) ([]accountExportEntity, bool, error) {
+		resp, err := client.ListEscalationPoliciesWithContext(ctx, pagerduty.ListEscalationPoliciesOptions{Limit: apiutil.Limit, Offset: uint(offset)})
+		if err != nil {
+			return nil, false, err
+		}
+		entities := make([]accountExportEntity, 0, len(resp.EscalationPolicies))
+		for _, ep := range resp.EscalationPolicies {
+			entities = append(entities, accountExportEntity{ep.ID, ep.Name, ep.Description, ep.HTMLURL})
+		}
+		return entities, resp.More, nil
+	},
+	"pagerduty_schedule": func(ctx context.Context, client *pagerduty.Client, offset int) ([]accountExportEntity, bool, error) {
+		resp, err := client.ListSchedulesWithContext(ctx, pagerduty.ListSchedulesOptions{Limit: apiutil.Limit, Offset: uint(offset)})
+		if err != nil {
+			return nil, false, err
+		}
+		entities := make([]accountExportEntity, 0, len(resp.Schedules))
+		for _, s := range resp.Schedules {
+			entities = append(entities, accountExportEntity{s.ID, s.Name, s.Description, s.HTMLURL})
+		}
+		return entities, resp.More, nil
+	},
+	"pagerduty_service": func(ctx context.Context, client *pagerduty.Client, offset int) ([]accountExportEntity, bool, error) {
+		resp, err := client.ListServicesWithContext(ctx, pagerduty.ListServiceOptions{Limit: apiutil.Limit, Offset: uint(offset)})
+		if err != nil {
+			return nil, false, err
+		}
+		entities := make([]accountExportEntity, 0, len(resp.Services))
+		for _, s := range resp.Services {
+			entities = append(entities, accountExportEntity{s.ID, s.Name, s.Description, s.HTMLURL})
+		}
+		return entities, resp.More, nil
+	},
+	"pagerduty_team": func(ctx context.Context, client *pagerduty.Client, offset int) ([]accountExportEntity, bool, error) {
+		resp, err := client.ListTeamsWithContext(ctx, pagerduty.ListTeamOptions{Limit: apiutil.Limit, Offset: uint(offset)})
+		if err != nil {
+			return nil, false, err
+		}
+		entities := make([]accountExportEntity, 0, len(resp.Teams))
+		for _, t := range resp.Teams {
+			entities = append(entities, accountExportEntity{t.ID, t.Name, t.Description, t.HTMLURL})
+		}
+		return entities, resp.More, nil
+	},
+}
+
+// requestListAccountExportEntities lists up to `maxEntities` entities of
+// `resourceType`, and reports whether the list was cut short.
+func requestListAccountExportEntities(ctx context.Context, client *pagerduty.Client, resourceType string, maxEntities int) ([]accountExportEntity, bool, error) {
+	listFn, ok := accountExportListers[resourceType]
+	if !ok {
+		return nil, false, fmt.Errorf("unsupported resource type %q", resourceType)
+	}
+
+	var entities []accountExportEntity
+	truncated := false
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		page, more, err := listFn(ctx, client, offset)
+		if err != nil {
+			return false, err
+		}
+
+		if len(entities)+len(page) > maxEntities {
+			entities = append(entities, page[:maxEntities-len(entities)]...)
+			truncated = true
+			return false, nil
+		}
+		entities = append(entities, page...)
+		if more && len(entities) == maxEntities {
+			truncated = true
+			return false, nil
+		}
+		return more, nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	return entities, truncated, nil
+}
+
+func flattenAccountExportEntities(ctx context.Context, list []accountExportEntity) (types.List, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	objects := make([]types.Object, 0, len(list))
+	for _, entity := range list {
+		item, diags := types.ObjectValue(
+			accountExportEntityObjectType.AttrTypes,
+			map[string]attr.Value{
+				"id":          types.StringValue(entity.ID),
+				"name":        types.StringValue(entity.Name),
+				"description": types.StringValue(entity.Description),
+				"html_url":    types.StringValue(entity.HTMLURL),
+			},
+		)
+		diagnostics.Append(diags...)
+		objects = append(objects, item)
+	}
+	listValue, diags := types.ListValueFrom(ctx, accountExportEntityObjectType, objects)
+	diagnostics.Append(diags...)
+	return listValue, diagnostics
+}
+
+type dataSourceAccountExportModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	MaxEntities  types.Int64  `tfsdk:"max_entities"`
+	Truncated    types.Bool   `tfsdk:"truncated"`
+	Entities     types.List   `tfsdk:"entities"`
+}
+
+var accountExportEntityObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"html_url":    types.StringType,
+	},
+}
@@ -0,0 +1,147 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestRequestListAccountExportEntities(t *testing.T) {
+	client := newTestClient(t, &Config{RetryTime: time.Second}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/services" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.URL.Query().Get("offset") {
+		case "":
+			fmt.Fprint(w, `{"services": [
+				{"id": "PSERVI1", "name": "Web", "description": "Web app", "html_url": "https://acme.pagerduty.com/service-directory/PSERVI1"},
+				{"id": "PSERVI2", "name": "API"}
+			], "more": true}`)
+		case "100":
+			fmt.Fprint(w, `{"services": [{"id": "PSERVI3", "name": "Workers"}], "more": false}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	cases := []struct {
+		maxEntities   int
+		wantIDs       []string
+		wantTruncated bool
+	}{
+		{10, []string{"PSERVI1", "PSERVI2", "PSERVI3"}, false},
+		{3, []string{"PSERVI1", "PSERVI2", "PSERVI3"}, false},
+		{2, []string{"PSERVI1", "PSERVI2"}, true},
+		{1, []string{"PSERVI1"}, true},
+	}
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("max %d", c.maxEntities), func(t *testing.T) {
+			entities, truncated, err := requestListAccountExportEntities(context.Background(), client, "pagerduty_service", c.maxEntities)
+			if err != nil {
+				t.Fatalf("error: expected the request to not fail: %v", err)
+			}
+			if truncated != c.wantTruncated {
+				t.Errorf("expected truncated %v, got %v", c.wantTruncated, truncated)
+			}
+			ids := make([]string, 0, len(entities))
+			for _, e := range entities {
+				ids = append(ids, e.ID)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(c.wantIDs) {
+				t.Errorf("expected services %v, got %v", c.wantIDs, ids)
+			}
+		})
+	}
+
+	entities, _, err := requestListAccountExportEntities(context.Background(), client, "pagerduty_service", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := accountExportEntity{"PSERVI1", "Web", "Web app", "https://acme.pagerduty.com/service-directory/PSERVI1"}
+	if entities[0] != want {
+		t.Errorf("expected service %#v, got %#v", want, entities[0])
+	}
+}
+
+func TestAccDataSourcePagerDutyAccountExport_Services(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyAccountExportServicesConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.pagerduty_account_export.services", "entities.#"),
+					testAccCheckPagerDutyAccountExportHasEntity("data.pagerduty_account_export.services", "pagerduty_service.test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyAccountExportHasEntity(n, src string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		srcR, ok := s.RootModule().Resources[src]
+		if !ok {
+			return fmt.Errorf("Not found: %s", src)
+		}
+		r, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		a := r.Primary.Attributes
+		for i := 0; a[fmt.Sprintf("entities.%d.id", i)] != ""; i++ {
+			if a[fmt.Sprintf("entities.%d.id", i)] == srcR.Primary.ID {
+				if got := a[fmt.Sprintf("entities.%d.name", i)]; got != srcR.Primary.Attributes["name"] {
+					return fmt.Errorf("Expected the exported name to be %s, got %s", srcR.Primary.Attributes["name"], got)
+				}
+				return nil
+			}
+		}
+		if a["truncated"] == "true" {
+			return nil
+		}
+		return fmt.Errorf("Expected %s to be exported", srcR.Primary.ID)
+	}
+}
+
+func testAccDataSourcePagerDutyAccountExportServicesConfig(name string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%[1]s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "test" {
+  name              = "%[1]s"
+  escalation_policy = pagerduty_escalation_policy.test.id
+}
+
+data "pagerduty_account_export" "services" {
+  resource_type = "pagerduty_service"
+  depends_on    = [pagerduty_service.test]
+}
+`, name)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -15,7 +14,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type dataSourceBusinessService struct{ client *pagerduty.Client }
+type dataSourceBusinessService struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var _ datasource.DataSourceWithConfigure = (*dataSourceBusinessService)(nil)
 
@@ -35,6 +37,7 @@ func (*dataSourceBusinessService) Schema(_ context.Context, _ datasource.SchemaR
 
 func (d *dataSourceBusinessService) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
 }
 
 func (d *dataSourceBusinessService) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -47,7 +50,7 @@ func (d *dataSourceBusinessService) Read(ctx context.Context, req datasource.Rea
 	}
 
 	var found *pagerduty.BusinessService
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, d.config.retryTimeLong(), func() *retry.RetryError {
 		list, err := d.client.ListBusinessServices(pagerduty.ListBusinessServiceOptions{})
 		if err != nil {
 			if util.IsBadRequestError(err) {
@@ -0,0 +1,115 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceExtension struct{ client *pagerduty.Client }
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceExtension)(nil)
+
+func (*dataSourceExtension) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_extension"
+}
+
+func (*dataSourceExtension) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":   schema.StringAttribute{Computed: true},
+			"name": schema.StringAttribute{Required: true},
+			"service": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only look for the extension among the ones attached to the service with this ID",
+			},
+			"endpoint_url": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"extension_schema": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (d *dataSourceExtension) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+}
+
+func (d *dataSourceExtension) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	log.Println("[INFO] Reading PagerDuty extension")
+
+	var model dataSourceExtensionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	searchName := model.Name.ValueString()
+
+	opts := pagerduty.ListExtensionOptions{
+		Limit: apiutil.Limit,
+		Query: searchName,
+	}
+	if !model.Service.IsNull() && !model.Service.IsUnknown() {
+		opts.ExtensionObjectID = model.Service.ValueString()
+	}
+
+	var found []pagerduty.Extension
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		opts.Offset = uint(offset)
+		list, err := d.client.ListExtensionsWithContext(ctx, opts)
+		if err != nil {
+			return false, err
+		}
+
+		for _, extension := range list.Extensions {
+			if extension.Name == searchName {
+				found = append(found, extension)
+			}
+		}
+
+		return list.More, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading PagerDuty extension %s", searchName),
+			err.Error(),
+		)
+		return
+	}
+
+	if len(found) == 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to locate any extension with the name: %s", searchName),
+			"",
+		)
+		return
+	}
+	if len(found) > 1 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Found %d extensions with the name: %s", len(found), searchName),
+			"Set the service attribute to look for the extension among the ones attached to a single service.",
+		)
+		return
+	}
+
+	extension := found[0]
+	model.ID = types.StringValue(extension.ID)
+	model.EndpointURL = types.StringValue(extension.EndpointURL)
+	model.ExtensionSchema = types.StringValue(extension.ExtensionSchema.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type dataSourceExtensionModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Service         types.String `tfsdk:"service"`
+	EndpointURL     types.String `tfsdk:"endpoint_url"`
+	ExtensionSchema types.String `tfsdk:"extension_schema"`
+}
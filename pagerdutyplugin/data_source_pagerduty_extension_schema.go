@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -16,7 +15,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type dataSourceExtensionSchema struct{ client *pagerduty.Client }
+type dataSourceExtensionSchema struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var _ datasource.DataSourceWithConfigure = (*dataSourceExtensionSchema)(nil)
 
@@ -36,6 +38,7 @@ func (*dataSourceExtensionSchema) Schema(_ context.Context, _ datasource.SchemaR
 
 func (d *dataSourceExtensionSchema) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
 }
 
 func (d *dataSourceExtensionSchema) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -51,7 +54,7 @@ func (d *dataSourceExtensionSchema) Read(ctx context.Context, req datasource.Rea
 	offset := 0
 	more := true
 	for more {
-		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+		err := retry.RetryContext(ctx, d.config.retryTime(), func() *retry.RetryError {
 			o := pagerduty.ListExtensionSchemaOptions{Limit: 20, Offset: uint(offset), Total: true}
 			list, err := d.client.ListExtensionSchemasWithContext(ctx, o)
 			if err != nil {
@@ -0,0 +1,88 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyExtension_Basic(t *testing.T) {
+	name := id.PrefixedUniqueId("tf-")
+	extensionName := id.PrefixedUniqueId("tf-")
+	url := "https://example.com/receive_a_pagerduty_webhook"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyExtensionConfig(name, extensionName, url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_extension.by_name", "id",
+						"pagerduty_extension.foo", "id",
+					),
+					resource.TestCheckResourceAttr("data.pagerduty_extension.by_name", "endpoint_url", url),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_extension.by_name", "extension_schema",
+						"data.pagerduty_extension_schema.foo", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_extension.by_service", "id",
+						"pagerduty_extension.foo", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyExtensionConfig(name, extensionName, url string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+  name  = "%[1]v"
+  email = "%[1]v@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+  name      = "%[1]v"
+  num_loops = 2
+
+  rule {
+    escalation_delay_in_minutes = 10
+
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.foo.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]v"
+  escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_extension_schema" "foo" {
+  name = "Generic V2 Webhook"
+}
+
+resource "pagerduty_extension" "foo" {
+  name              = "%[2]v"
+  endpoint_url      = "%[3]v"
+  extension_schema  = data.pagerduty_extension_schema.foo.id
+  extension_objects = [pagerduty_service.foo.id]
+}
+
+data "pagerduty_extension" "by_name" {
+  name = pagerduty_extension.foo.name
+}
+
+data "pagerduty_extension" "by_service" {
+  name    = pagerduty_extension.foo.name
+  service = pagerduty_service.foo.id
+}
+`, name, extensionName, url)
+}
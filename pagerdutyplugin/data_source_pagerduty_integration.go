@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -18,7 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type dataSourceIntegration struct{ client *pagerduty.Client }
+type dataSourceIntegration struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var _ datasource.DataSourceWithConfigure = (*dataSourceIntegration)(nil)
 
@@ -42,6 +44,7 @@ func (*dataSourceIntegration) Schema(ctx context.Context, req datasource.SchemaR
 
 func (d *dataSourceIntegration) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
 }
 
 func (d *dataSourceIntegration) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -107,7 +110,7 @@ func (d *dataSourceIntegration) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	var model dataSourceIntegrationModel
-	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err = retry.RetryContext(ctx, d.config.retryTime(), func() *retry.RetryError {
 		details, err := d.client.GetIntegrationWithContext(ctx, found.ID, foundIntegration.ID, pagerduty.GetIntegrationOptions{})
 		if err != nil {
 			if util.IsBadRequestError(err) {
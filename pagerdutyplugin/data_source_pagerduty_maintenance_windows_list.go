@@ -0,0 +1,129 @@
+// The file name avoids the "_windows" suffix, which Go reads as a build
+// constraint for the Windows operating system.
+
+package pagerduty
+
+import (
+	"context"
+	"log"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceMaintenanceWindows struct {
+	client *pagerduty.Client
+}
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceMaintenanceWindows)(nil)
+
+func (d *dataSourceMaintenanceWindows) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_maintenance_windows"
+}
+
+func (d *dataSourceMaintenanceWindows) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list the maintenance windows of the service with this ID",
+			},
+			"maintenance_windows": schema.ListAttribute{
+				ElementType: maintenanceWindowObjectType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceMaintenanceWindows) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+}
+
+func (d *dataSourceMaintenanceWindows) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	log.Printf("[INFO] Reading PagerDuty maintenance windows")
+
+	var data dataSourceMaintenanceWindowsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := pagerduty.ListMaintenanceWindowsOptions{Limit: apiutil.Limit}
+	if !data.ServiceID.IsNull() && !data.ServiceID.IsUnknown() {
+		opts.ServiceIDs = []string{data.ServiceID.ValueString()}
+	}
+
+	var windows []pagerduty.MaintenanceWindow
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		opts.Offset = uint(offset)
+		list, err := d.client.ListMaintenanceWindowsWithContext(ctx, opts)
+		if err != nil {
+			return false, err
+		}
+
+		windows = append(windows, list.MaintenanceWindows...)
+		return list.More, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error calling ListMaintenanceWindowsWithContext", err.Error())
+		return
+	}
+
+	list, diags := flattenMaintenanceWindows(ctx, windows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.MaintenanceWindows = list
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func flattenMaintenanceWindows(ctx context.Context, list []pagerduty.MaintenanceWindow) (types.List, diag.Diagnostics) {
+	var diagnostics diag.Diagnostics
+	mapList := make([]types.Object, 0, len(list))
+	for _, window := range list {
+		serviceIDs := make([]string, 0, len(window.Services))
+		for _, s := range window.Services {
+			serviceIDs = append(serviceIDs, s.ID)
+		}
+		services, diags := types.ListValueFrom(ctx, types.StringType, serviceIDs)
+		diagnostics.Append(diags...)
+
+		item, diags := types.ObjectValue(
+			maintenanceWindowObjectType.AttrTypes,
+			map[string]attr.Value{
+				"id":          types.StringValue(window.ID),
+				"description": types.StringValue(window.Description),
+				"start_time":  types.StringValue(window.StartTime),
+				"end_time":    types.StringValue(window.EndTime),
+				"services":    services,
+			},
+		)
+		diagnostics.Append(diags...)
+		mapList = append(mapList, item)
+	}
+	listValue, diags := types.ListValueFrom(ctx, maintenanceWindowObjectType, mapList)
+	diagnostics.Append(diags...)
+	return listValue, diagnostics
+}
+
+type dataSourceMaintenanceWindowsModel struct {
+	ServiceID          types.String `tfsdk:"service_id"`
+	MaintenanceWindows types.List   `tfsdk:"maintenance_windows"`
+}
+
+var maintenanceWindowObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":          types.StringType,
+		"description": types.StringType,
+		"start_time":  types.StringType,
+		"end_time":    types.StringType,
+		"services":    types.ListType{ElemType: types.StringType},
+	},
+}
@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyMaintenanceWindows_ByService(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	start := time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	end := time.Now().Add(48 * time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyMaintenanceWindowsConfig(name, start, end),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.by_service", "maintenance_windows.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_maintenance_windows.by_service", "maintenance_windows.0.id",
+						"pagerduty_maintenance_window.foo", "id",
+					),
+					resource.TestCheckResourceAttr("data.pagerduty_maintenance_windows.by_service", "maintenance_windows.0.description", name+"-foo"),
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_maintenance_windows.by_service", "maintenance_windows.0.services.0",
+						"pagerduty_service.foo", "id",
+					),
+					resource.TestCheckResourceAttrSet("data.pagerduty_maintenance_windows.by_service", "maintenance_windows.0.start_time"),
+					resource.TestCheckResourceAttrSet("data.pagerduty_maintenance_windows.by_service", "maintenance_windows.0.end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyMaintenanceWindowsConfig(name, start, end string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%[1]s"
+  email = "%[1]s@foo.test"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%[1]s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "foo" {
+  name              = "%[1]s-foo"
+  escalation_policy = pagerduty_escalation_policy.test.id
+}
+
+resource "pagerduty_service" "bar" {
+  name              = "%[1]s-bar"
+  escalation_policy = pagerduty_escalation_policy.test.id
+}
+
+resource "pagerduty_maintenance_window" "foo" {
+  description = "%[1]s-foo"
+  start_time  = "%[2]s"
+  end_time    = "%[3]s"
+  services    = [pagerduty_service.foo.id]
+}
+
+resource "pagerduty_maintenance_window" "bar" {
+  description = "%[1]s-bar"
+  start_time  = "%[2]s"
+  end_time    = "%[3]s"
+  services    = [pagerduty_service.bar.id]
+}
+
+data "pagerduty_maintenance_windows" "by_service" {
+  service_id = pagerduty_service.foo.id
+  depends_on = [pagerduty_maintenance_window.foo, pagerduty_maintenance_window.bar]
+}
+`, name, start, end)
+}
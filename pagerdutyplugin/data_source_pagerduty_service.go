@@ -44,6 +44,21 @@ func (d *dataSourceService) Schema(ctx context.Context, req datasource.SchemaReq
 					},
 				},
 			},
+			"incident_urgency_rule": schema.ListAttribute{
+				Computed:    true,
+				Description: "The default urgency for new incidents on the service",
+				ElementType: serviceIncidentUrgencyRuleObjectType,
+			},
+			"support_hours": schema.ListAttribute{
+				Computed:    true,
+				Description: "The support hours for the service",
+				ElementType: serviceSupportHoursObjectType,
+			},
+			"scheduled_actions": schema.ListAttribute{
+				Computed:    true,
+				Description: "The scheduled actions for the service",
+				ElementType: serviceScheduledActionObjectType,
+			},
 		},
 	}
 }
@@ -115,8 +130,50 @@ type dataSourceServiceModel struct {
 	EscalationPolicy       types.String `tfsdk:"escalation_policy"`
 	Type                   types.String `tfsdk:"type"`
 	Teams                  types.List   `tfsdk:"teams"`
+	IncidentUrgencyRule    types.List   `tfsdk:"incident_urgency_rule"`
+	SupportHours           types.List   `tfsdk:"support_hours"`
+	ScheduledActions       types.List   `tfsdk:"scheduled_actions"`
 }
 
+var (
+	serviceIncidentUrgencyTypeObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":    types.StringType,
+			"urgency": types.StringType,
+		},
+	}
+	serviceIncidentUrgencyRuleObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":                  types.StringType,
+			"urgency":               types.StringType,
+			"during_support_hours":  types.ListType{ElemType: serviceIncidentUrgencyTypeObjectType},
+			"outside_support_hours": types.ListType{ElemType: serviceIncidentUrgencyTypeObjectType},
+		},
+	}
+	serviceSupportHoursObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":         types.StringType,
+			"time_zone":    types.StringType,
+			"start_time":   types.StringType,
+			"end_time":     types.StringType,
+			"days_of_week": types.ListType{ElemType: types.Int64Type},
+		},
+	}
+	serviceScheduledActionAtObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type": types.StringType,
+			"name": types.StringType,
+		},
+	}
+	serviceScheduledActionObjectType = types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"type":       types.StringType,
+			"to_urgency": types.StringType,
+			"at":         types.ListType{ElemType: serviceScheduledActionAtObjectType},
+		},
+	}
+)
+
 func flattenServiceData(service *pagerduty.Service, diags *diag.Diagnostics) dataSourceServiceModel {
 	teamObjectType := types.ObjectType{
 		AttrTypes: map[string]attr.Type{
@@ -149,6 +206,9 @@ func flattenServiceData(service *pagerduty.Service, diags *diag.Diagnostics) dat
 		Description:            types.StringValue(service.Description),
 		EscalationPolicy:       types.StringValue(service.EscalationPolicy.ID),
 		Teams:                  teams,
+		IncidentUrgencyRule:    flattenServiceIncidentUrgencyRule(service.IncidentUrgencyRule, diags),
+		SupportHours:           flattenServiceSupportHours(service.SupportHours, diags),
+		ScheduledActions:       flattenServiceScheduledActions(service.ScheduledActions, diags),
 	}
 
 	if service.AutoResolveTimeout != nil {
@@ -159,3 +219,89 @@ func flattenServiceData(service *pagerduty.Service, diags *diag.Diagnostics) dat
 	}
 	return model
 }
+
+func flattenServiceIncidentUrgencyRule(rule *pagerduty.IncidentUrgencyRule, diags *diag.Diagnostics) types.List {
+	if rule == nil {
+		return types.ListValueMust(serviceIncidentUrgencyRuleObjectType, []attr.Value{})
+	}
+
+	obj, d := types.ObjectValue(serviceIncidentUrgencyRuleObjectType.AttrTypes, map[string]attr.Value{
+		"type":                  types.StringValue(rule.Type),
+		"urgency":               types.StringValue(rule.Urgency),
+		"during_support_hours":  flattenServiceIncidentUrgencyType(rule.DuringSupportHours, diags),
+		"outside_support_hours": flattenServiceIncidentUrgencyType(rule.OutsideSupportHours, diags),
+	})
+	diags.Append(d...)
+
+	list, d := types.ListValue(serviceIncidentUrgencyRuleObjectType, []attr.Value{obj})
+	diags.Append(d...)
+	return list
+}
+
+func flattenServiceIncidentUrgencyType(urgency *pagerduty.IncidentUrgencyType, diags *diag.Diagnostics) types.List {
+	if urgency == nil {
+		return types.ListValueMust(serviceIncidentUrgencyTypeObjectType, []attr.Value{})
+	}
+
+	obj, d := types.ObjectValue(serviceIncidentUrgencyTypeObjectType.AttrTypes, map[string]attr.Value{
+		"type":    types.StringValue(urgency.Type),
+		"urgency": types.StringValue(urgency.Urgency),
+	})
+	diags.Append(d...)
+
+	list, d := types.ListValue(serviceIncidentUrgencyTypeObjectType, []attr.Value{obj})
+	diags.Append(d...)
+	return list
+}
+
+func flattenServiceSupportHours(hours *pagerduty.SupportHours, diags *diag.Diagnostics) types.List {
+	if hours == nil {
+		return types.ListValueMust(serviceSupportHoursObjectType, []attr.Value{})
+	}
+
+	days := make([]attr.Value, 0, len(hours.DaysOfWeek))
+	for _, day := range hours.DaysOfWeek {
+		days = append(days, types.Int64Value(int64(day)))
+	}
+	daysOfWeek, d := types.ListValue(types.Int64Type, days)
+	diags.Append(d...)
+
+	obj, d := types.ObjectValue(serviceSupportHoursObjectType.AttrTypes, map[string]attr.Value{
+		"type":         types.StringValue(hours.Type),
+		"time_zone":    types.StringValue(hours.Timezone),
+		"start_time":   types.StringValue(hours.StartTime),
+		"end_time":     types.StringValue(hours.EndTime),
+		"days_of_week": daysOfWeek,
+	})
+	diags.Append(d...)
+
+	list, d := types.ListValue(serviceSupportHoursObjectType, []attr.Value{obj})
+	diags.Append(d...)
+	return list
+}
+
+func flattenServiceScheduledActions(actions []pagerduty.ScheduledAction, diags *diag.Diagnostics) types.List {
+	elements := make([]attr.Value, 0, len(actions))
+	for _, sa := range actions {
+		at, d := types.ObjectValue(serviceScheduledActionAtObjectType.AttrTypes, map[string]attr.Value{
+			"type": types.StringValue(sa.At.Type),
+			"name": types.StringValue(sa.At.Name),
+		})
+		diags.Append(d...)
+
+		atList, d := types.ListValue(serviceScheduledActionAtObjectType, []attr.Value{at})
+		diags.Append(d...)
+
+		obj, d := types.ObjectValue(serviceScheduledActionObjectType.AttrTypes, map[string]attr.Value{
+			"type":       types.StringValue(sa.Type),
+			"to_urgency": types.StringValue(sa.ToUrgency),
+			"at":         atList,
+		})
+		diags.Append(d...)
+		elements = append(elements, obj)
+	}
+
+	list, d := types.ListValue(serviceScheduledActionObjectType, elements)
+	diags.Append(d...)
+	return list
+}
@@ -0,0 +1,179 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type dataSourceServiceAnalytics struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceServiceAnalytics)(nil)
+
+func (*dataSourceServiceAnalytics) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_service_analytics"
+}
+
+func (*dataSourceServiceAnalytics) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":      schema.StringAttribute{Computed: true},
+			"service": schema.StringAttribute{Required: true},
+			"created_at_start": schema.StringAttribute{
+				Required:    true,
+				Description: "Start of the time range of the incidents to aggregate, in ISO 8601 format",
+			},
+			"created_at_end": schema.StringAttribute{
+				Required:    true,
+				Description: "End of the time range of the incidents to aggregate, in ISO 8601 format",
+			},
+			"urgency": schema.StringAttribute{
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.OneOf("high", "low")},
+			},
+			"mean_seconds_to_resolve":           schema.Int64Attribute{Computed: true},
+			"mean_seconds_to_first_ack":         schema.Int64Attribute{Computed: true},
+			"mean_seconds_to_engage":            schema.Int64Attribute{Computed: true},
+			"mean_seconds_to_mobilize":          schema.Int64Attribute{Computed: true},
+			"mean_engaged_seconds":              schema.Int64Attribute{Computed: true},
+			"mean_engaged_user_count":           schema.Int64Attribute{Computed: true},
+			"mean_assignment_count":             schema.Int64Attribute{Computed: true},
+			"total_incident_count":              schema.Int64Attribute{Computed: true},
+			"total_escalation_count":            schema.Int64Attribute{Computed: true},
+			"total_business_hour_interruptions": schema.Int64Attribute{Computed: true},
+			"total_off_hour_interruptions":      schema.Int64Attribute{Computed: true},
+			"total_sleep_hour_interruptions":    schema.Int64Attribute{Computed: true},
+			"total_engaged_seconds":             schema.Int64Attribute{Computed: true},
+			"total_snoozed_seconds":             schema.Int64Attribute{Computed: true},
+			"up_time_pct":                       schema.Float64Attribute{Computed: true},
+		},
+	}
+}
+
+func (d *dataSourceServiceAnalytics) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
+}
+
+func (d *dataSourceServiceAnalytics) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model dataSourceServiceAnalyticsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []string{"created_at_start", "created_at_end"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attr), &value)...)
+		if _, err := time.Parse(time.RFC3339, value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Invalid date",
+				fmt.Sprintf("%s must be in ISO 8601 format, e.g. 2024-01-01T00:00:00Z: %s", attr, err),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serviceID := model.Service.ValueString()
+	log.Printf("[INFO] Reading PagerDuty analytics of service %s", serviceID)
+
+	analyticsRequest := pagerduty.AnalyticsRequest{
+		Filters: &pagerduty.AnalyticsFilter{
+			CreatedAtStart: model.CreatedAtStart.ValueString(),
+			CreatedAtEnd:   model.CreatedAtEnd.ValueString(),
+			Urgency:        model.Urgency.ValueString(),
+			ServiceIDs:     []string{serviceID},
+		},
+	}
+
+	var data pagerduty.AnalyticsData
+	err := retry.RetryContext(ctx, d.config.retryTime(), func() *retry.RetryError {
+		response, err := d.client.GetAggregatedServiceData(ctx, analyticsRequest)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+
+		// Services without incidents in the time range are left out of the
+		// response, so their metrics stay at zero.
+		data = pagerduty.AnalyticsData{}
+		for _, item := range response.Data {
+			if item.ServiceID == serviceID {
+				data = item
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading PagerDuty analytics of service %s", serviceID),
+			err.Error(),
+		)
+		return
+	}
+
+	flattenServiceAnalytics(data, &model)
+	model.ID = types.StringValue(serviceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func flattenServiceAnalytics(data pagerduty.AnalyticsData, model *dataSourceServiceAnalyticsModel) {
+	model.MeanSecondsToResolve = types.Int64Value(int64(data.MeanSecondsToResolve))
+	model.MeanSecondsToFirstAck = types.Int64Value(int64(data.MeanSecondsToFirstAck))
+	model.MeanSecondsToEngage = types.Int64Value(int64(data.MeanSecondsToEngage))
+	model.MeanSecondsToMobilize = types.Int64Value(int64(data.MeanSecondsToMobilize))
+	model.MeanEngagedSeconds = types.Int64Value(int64(data.MeanEngagedSeconds))
+	model.MeanEngagedUserCount = types.Int64Value(int64(data.MeanEngagedUserCount))
+	model.MeanAssignmentCount = types.Int64Value(int64(data.MeanAssignmentCount))
+	model.TotalIncidentCount = types.Int64Value(int64(data.TotalIncidentCount))
+	model.TotalEscalationCount = types.Int64Value(int64(data.TotalEscalationCount))
+	model.TotalBusinessHourInterruptions = types.Int64Value(int64(data.TotalBusinessHourInterruptions))
+	model.TotalOffHourInterruptions = types.Int64Value(int64(data.TotalOffHourInterruptions))
+	model.TotalSleepHourInterruptions = types.Int64Value(int64(data.TotalSleepHourInterruptions))
+	model.TotalEngagedSeconds = types.Int64Value(int64(data.TotalEngagedSeconds))
+	model.TotalSnoozedSeconds = types.Int64Value(int64(data.TotalSnoozedSeconds))
+	model.UpTimePct = types.Float64Value(data.UpTimePct)
+}
+
+type dataSourceServiceAnalyticsModel struct {
+	ID                             types.String  `tfsdk:"id"`
+	Service                        types.String  `tfsdk:"service"`
+	CreatedAtStart                 types.String  `tfsdk:"created_at_start"`
+	CreatedAtEnd                   types.String  `tfsdk:"created_at_end"`
+	Urgency                        types.String  `tfsdk:"urgency"`
+	MeanSecondsToResolve           types.Int64   `tfsdk:"mean_seconds_to_resolve"`
+	MeanSecondsToFirstAck          types.Int64   `tfsdk:"mean_seconds_to_first_ack"`
+	MeanSecondsToEngage            types.Int64   `tfsdk:"mean_seconds_to_engage"`
+	MeanSecondsToMobilize          types.Int64   `tfsdk:"mean_seconds_to_mobilize"`
+	MeanEngagedSeconds             types.Int64   `tfsdk:"mean_engaged_seconds"`
+	MeanEngagedUserCount           types.Int64   `tfsdk:"mean_engaged_user_count"`
+	MeanAssignmentCount            types.Int64   `tfsdk:"mean_assignment_count"`
+	TotalIncidentCount             types.Int64   `tfsdk:"total_incident_count"`
+	TotalEscalationCount           types.Int64   `tfsdk:"total_escalation_count"`
+	TotalBusinessHourInterruptions types.Int64   `tfsdk:"total_business_hour_interruptions"`
+	TotalOffHourInterruptions      types.Int64   `tfsdk:"total_off_hour_interruptions"`
+	TotalSleepHourInterruptions    types.Int64   `tfsdk:"total_sleep_hour_interruptions"`
+	TotalEngagedSeconds            types.Int64   `tfsdk:"total_engaged_seconds"`
+	TotalSnoozedSeconds            types.Int64   `tfsdk:"total_snoozed_seconds"`
+	UpTimePct                      types.Float64 `tfsdk:"up_time_pct"`
+}
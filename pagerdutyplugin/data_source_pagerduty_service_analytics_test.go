@@ -0,0 +1,88 @@
+package pagerduty
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePagerDutyServiceAnalytics_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	end := time.Now().UTC().Truncate(time.Hour)
+	start := end.AddDate(0, 0, -7)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceAnalyticsConfig(username, email, escalationPolicy, service, start.Format(time.RFC3339), end.Format(time.RFC3339)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.pagerduty_service_analytics.foo", "id", "pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_service_analytics.foo", "total_incident_count", "0"),
+					resource.TestCheckResourceAttr(
+						"data.pagerduty_service_analytics.foo", "mean_seconds_to_resolve", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourcePagerDutyServiceAnalytics_InvalidDate(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyServiceAnalyticsConfig(username, email, escalationPolicy, service, "2024-01-01", "2024-01-31T00:00:00Z"),
+				ExpectError: regexp.MustCompile("created_at_start must be in ISO 8601 format"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyServiceAnalyticsConfig(username, email, escalationPolicy, service, start, end string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 1
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_service_analytics" "foo" {
+	service          = pagerduty_service.foo.id
+	created_at_start = "%s"
+	created_at_end   = "%s"
+}
+`, username, email, escalationPolicy, service, start, end)
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -73,6 +76,138 @@ func TestAccDataSourcePagerDutyService_HasOneTeam(t *testing.T) {
 	})
 }
 
+func TestAccDataSourcePagerDutyService_SupportHours(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	dataSourceName := "data.pagerduty_service.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceSupportHoursConfig(username, email, service, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "incident_urgency_rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "incident_urgency_rule.0.type", "use_support_hours"),
+					resource.TestCheckResourceAttr(dataSourceName, "incident_urgency_rule.0.during_support_hours.0.type", "constant"),
+					resource.TestCheckResourceAttr(dataSourceName, "incident_urgency_rule.0.during_support_hours.0.urgency", "high"),
+					resource.TestCheckResourceAttr(dataSourceName, "incident_urgency_rule.0.outside_support_hours.0.type", "constant"),
+					resource.TestCheckResourceAttr(dataSourceName, "incident_urgency_rule.0.outside_support_hours.0.urgency", "low"),
+					resource.TestCheckResourceAttr(dataSourceName, "support_hours.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "support_hours.0.type", "fixed_time_per_day"),
+					resource.TestCheckResourceAttr(dataSourceName, "support_hours.0.time_zone", "America/Lima"),
+					resource.TestCheckResourceAttr(dataSourceName, "support_hours.0.start_time", "09:00:00"),
+					resource.TestCheckResourceAttr(dataSourceName, "support_hours.0.end_time", "17:00:00"),
+					resource.TestCheckResourceAttr(dataSourceName, "support_hours.0.days_of_week.#", "5"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenServiceDataUrgencyRuleAndSupportHours(t *testing.T) {
+	var diags diag.Diagnostics
+	model := flattenServiceData(&pagerduty.Service{Name: "foo"}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if n := len(model.IncidentUrgencyRule.Elements()); n != 0 {
+		t.Errorf("expected no incident_urgency_rule, got %d", n)
+	}
+	if n := len(model.SupportHours.Elements()); n != 0 {
+		t.Errorf("expected no support_hours, got %d", n)
+	}
+
+	model = flattenServiceData(&pagerduty.Service{
+		Name: "foo",
+		IncidentUrgencyRule: &pagerduty.IncidentUrgencyRule{
+			Type:               "use_support_hours",
+			DuringSupportHours: &pagerduty.IncidentUrgencyType{Type: "constant", Urgency: "high"},
+		},
+		SupportHours: &pagerduty.SupportHours{
+			Type:       "fixed_time_per_day",
+			Timezone:   "America/Lima",
+			StartTime:  "09:00:00",
+			EndTime:    "17:00:00",
+			DaysOfWeek: []uint{1, 2, 3},
+		},
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if n := len(model.IncidentUrgencyRule.Elements()); n != 1 {
+		t.Fatalf("expected one incident_urgency_rule, got %d", n)
+	}
+	rule := model.IncidentUrgencyRule.Elements()[0].(types.Object).Attributes()
+	if n := len(rule["during_support_hours"].(types.List).Elements()); n != 1 {
+		t.Errorf("expected during_support_hours to be set, got %d", n)
+	}
+	if n := len(rule["outside_support_hours"].(types.List).Elements()); n != 0 {
+		t.Errorf("expected no outside_support_hours, got %d", n)
+	}
+	hours := model.SupportHours.Elements()[0].(types.Object).Attributes()
+	if n := len(hours["days_of_week"].(types.List).Elements()); n != 3 {
+		t.Errorf("expected three days_of_week, got %d", n)
+	}
+}
+
+func TestAccDataSourcePagerDutyService_ScheduledActions(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	dataSourceName := "data.pagerduty_service.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutyServiceScheduledActionsConfig(username, email, service, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "scheduled_actions.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "scheduled_actions.0.type", "urgency_change"),
+					resource.TestCheckResourceAttr(dataSourceName, "scheduled_actions.0.to_urgency", "high"),
+					resource.TestCheckResourceAttr(dataSourceName, "scheduled_actions.0.at.0.type", "named_time"),
+					resource.TestCheckResourceAttr(dataSourceName, "scheduled_actions.0.at.0.name", "support_hours_start"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenServiceScheduledActions(t *testing.T) {
+	var diags diag.Diagnostics
+	list := flattenServiceScheduledActions([]pagerduty.ScheduledAction{
+		{
+			Type:      "urgency_change",
+			ToUrgency: "high",
+			At:        pagerduty.InlineModel{Type: "named_time", Name: "support_hours_start"},
+		},
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if n := len(list.Elements()); n != 1 {
+		t.Fatalf("expected one scheduled action, got %d", n)
+	}
+	sa := list.Elements()[0].(types.Object).Attributes()
+	if got := sa["to_urgency"].(types.String).ValueString(); got != "high" {
+		t.Errorf("expected to_urgency high, got %q", got)
+	}
+	at := sa["at"].(types.List).Elements()[0].(types.Object).Attributes()
+	if got := at["name"].(types.String).ValueString(); got != "support_hours_start" {
+		t.Errorf("expected at.name support_hours_start, got %q", got)
+	}
+
+	if list := flattenServiceScheduledActions(nil, &diags); len(list.Elements()) != 0 || list.IsNull() {
+		t.Errorf("expected an empty list of scheduled actions, got %v", list)
+	}
+}
+
 func testAccDataSourcePagerDutyService(src, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		srcR := s.RootModule().Resources[src]
@@ -164,3 +299,116 @@ data "pagerduty_service" "one_team_service" {
 
 `, teamname, username, email, service, escalationPolicy)
 }
+
+func testAccDataSourcePagerDutyServiceSupportHoursConfig(username, email, service, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "test" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.test.id
+
+  incident_urgency_rule {
+    type = "use_support_hours"
+
+    during_support_hours {
+      type    = "constant"
+      urgency = "high"
+    }
+
+    outside_support_hours {
+      type    = "constant"
+      urgency = "low"
+    }
+  }
+
+  support_hours {
+    type         = "fixed_time_per_day"
+    time_zone    = "America/Lima"
+    start_time   = "09:00:00"
+    end_time     = "17:00:00"
+    days_of_week = [1, 2, 3, 4, 5]
+  }
+}
+
+data "pagerduty_service" "by_name" {
+  name = pagerduty_service.test.name
+}
+`, username, email, escalationPolicy, service)
+}
+
+func testAccDataSourcePagerDutyServiceScheduledActionsConfig(username, email, service, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "test" {
+  name  = "%s"
+  email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "test" {
+  name      = "%s"
+  num_loops = 2
+  rule {
+    escalation_delay_in_minutes = 10
+    target {
+      type = "user_reference"
+      id   = pagerduty_user.test.id
+    }
+  }
+}
+
+resource "pagerduty_service" "test" {
+  name              = "%s"
+  escalation_policy = pagerduty_escalation_policy.test.id
+
+  incident_urgency_rule {
+    type = "use_support_hours"
+
+    during_support_hours {
+      type    = "constant"
+      urgency = "high"
+    }
+
+    outside_support_hours {
+      type    = "constant"
+      urgency = "low"
+    }
+  }
+
+  support_hours {
+    type         = "fixed_time_per_day"
+    time_zone    = "America/Lima"
+    start_time   = "09:00:00"
+    end_time     = "17:00:00"
+    days_of_week = [1, 2, 3, 4, 5]
+  }
+
+  scheduled_actions {
+    type       = "urgency_change"
+    to_urgency = "high"
+    at {
+      type = "named_time"
+      name = "support_hours_start"
+    }
+  }
+}
+
+data "pagerduty_service" "by_name" {
+  name = pagerduty_service.test.name
+}
+`, username, email, escalationPolicy, service)
+}
@@ -0,0 +1,132 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type dataSourceSlackWorkspace struct {
+	config *Config
+}
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceSlackWorkspace)(nil)
+
+func (d *dataSourceSlackWorkspace) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_slack_workspace"
+}
+
+func (d *dataSourceSlackWorkspace) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the Slack workspace connected to PagerDuty",
+			},
+			"id": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (d *dataSourceSlackWorkspace) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
+}
+
+func (d *dataSourceSlackWorkspace) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var searchName string
+	if d := req.Config.GetAttribute(ctx, path.Root("name"), &searchName); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	log.Printf("[INFO] Reading PagerDuty slack workspace %s", searchName)
+
+	workspaces, err := requestListSlackWorkspaces(ctx, d.config)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading list of slack workspaces", err.Error())
+		return
+	}
+
+	var found *slackWorkspace
+	for _, w := range workspaces {
+		if w.Name == searchName {
+			found = w
+			break
+		}
+	}
+	if found == nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to locate any slack workspace with name: %s", searchName),
+			"",
+		)
+		return
+	}
+
+	model := dataSourceSlackWorkspaceModel{
+		ID:   types.StringValue(found.ID),
+		Name: types.StringValue(found.Name),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type dataSourceSlackWorkspaceModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+// slackWorkspace is a Slack workspace connected to the PagerDuty account.
+type slackWorkspace struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// requestListSlackWorkspaces lists the Slack workspaces connected to the
+// PagerDuty account. The endpoint belongs to the Slack integration, which is
+// served from the PagerDuty APP URL and isn't covered by the API client.
+func requestListSlackWorkspaces(ctx context.Context, config *Config) ([]*slackWorkspace, error) {
+	client, err := config.SlackClient()
+	if err != nil {
+		return nil, err
+	}
+
+	var workspaces []*slackWorkspace
+	err = retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.AppURL+"/integration-slack/workspaces", nil)
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		resp, err := client.Do(req, true)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			err := fmt.Errorf("GET %s: unexpected status %s", req.URL, resp.Status)
+			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		var body struct {
+			Workspaces []*slackWorkspace `json:"workspaces"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return retry.NonRetryableError(err)
+		}
+		workspaces = body.Workspaces
+		return nil
+	})
+
+	return workspaces, err
+}
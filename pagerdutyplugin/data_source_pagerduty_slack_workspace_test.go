@@ -0,0 +1,80 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRequestListSlackWorkspaces(t *testing.T) {
+	config := &Config{UserToken: "bar"}
+	newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/integration-slack/workspaces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Token token=bar" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"workspaces":[{"id":"T0001","name":"Acme"},{"id":"T0002","name":"Acme Ops"}]}`)
+	})
+
+	workspaces, err := requestListSlackWorkspaces(context.Background(), config)
+	if err != nil {
+		t.Fatalf("error: expected the request to not fail: %v", err)
+	}
+	if len(workspaces) != 2 {
+		t.Fatalf("expected 2 workspaces, got %d", len(workspaces))
+	}
+	if workspaces[1].ID != "T0002" || workspaces[1].Name != "Acme Ops" {
+		t.Errorf("unexpected workspace: %#v", workspaces[1])
+	}
+}
+
+func TestRequestListSlackWorkspacesEmptyUserToken(t *testing.T) {
+	config := Config{Token: "foo", SkipCredsValidation: true}
+	if _, err := config.Client(context.Background()); err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+
+	if _, err := requestListSlackWorkspaces(context.Background(), &config); err == nil {
+		t.Fatalf("expected the request to fail without a user token")
+	}
+}
+
+func TestAccDataSourcePagerDutySlackWorkspace_Basic(t *testing.T) {
+	workspaceID := os.Getenv("SLACK_CONNECTION_WORKSPACE_ID")
+	workspaceName := os.Getenv("SLACK_CONNECTION_WORKSPACE_NAME")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if workspaceID == "" || workspaceName == "" {
+				t.Skip("SLACK_CONNECTION_WORKSPACE_ID and SLACK_CONNECTION_WORKSPACE_NAME must be set")
+			}
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourcePagerDutySlackWorkspaceConfig(workspaceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.pagerduty_slack_workspace.test", "id", workspaceID),
+					resource.TestCheckResourceAttr("data.pagerduty_slack_workspace.test", "name", workspaceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutySlackWorkspaceConfig(name string) string {
+	return fmt.Sprintf(`
+data "pagerduty_slack_workspace" "test" {
+  name = "%s"
+}
+`, name)
+}
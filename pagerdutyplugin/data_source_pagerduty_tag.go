@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -17,6 +16,7 @@ import (
 
 type dataSourceTag struct {
 	client *pagerduty.Client
+	config *Config
 }
 
 var _ datasource.DataSourceWithConfigure = (*dataSourceStandards)(nil)
@@ -39,6 +39,7 @@ func (d *dataSourceTag) Schema(_ context.Context, _ datasource.SchemaRequest, re
 
 func (d *dataSourceTag) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
 }
 
 func (d *dataSourceTag) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -51,7 +52,7 @@ func (d *dataSourceTag) Read(ctx context.Context, req datasource.ReadRequest, re
 	log.Printf("[INFO] Reading PagerDuty tag")
 
 	var tags []*pagerduty.Tag
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, d.config.retryTime(), func() *retry.RetryError {
 		list, err := d.client.ListTagsPaginated(ctx, pagerduty.ListTagOptions{Query: searchTag, Limit: 100})
 		if err != nil {
 			if util.IsBadRequestError(err) {
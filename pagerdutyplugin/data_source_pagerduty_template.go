@@ -0,0 +1,149 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util/apiutil"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type dataSourceTemplate struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var _ datasource.DataSourceWithConfigure = (*dataSourceTemplate)(nil)
+
+func (*dataSourceTemplate) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = "pagerduty_template"
+}
+
+func (*dataSourceTemplate) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":            schema.StringAttribute{Computed: true},
+			"name":          schema.StringAttribute{Required: true},
+			"description":   schema.StringAttribute{Computed: true},
+			"template_type": schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func (d *dataSourceTemplate) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&d.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&d.config, req.ProviderData)...)
+}
+
+func (d *dataSourceTemplate) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	log.Println("[INFO] Reading PagerDuty template")
+
+	var model dataSourceTemplateModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	searchName := model.Name.ValueString()
+
+	var found []*template
+	err := apiutil.All(ctx, func(offset int) (bool, error) {
+		list, err := requestListTemplates(ctx, d.client, d.config, searchName, offset)
+		if err != nil {
+			return false, err
+		}
+
+		for _, t := range list.Templates {
+			if t.Name == searchName {
+				found = append(found, t)
+			}
+		}
+
+		return list.More, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error reading PagerDuty template %s", searchName),
+			err.Error(),
+		)
+		return
+	}
+
+	if len(found) == 0 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Unable to locate any template with the name: %s", searchName),
+			"",
+		)
+		return
+	}
+	if len(found) > 1 {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Found %d templates with the name: %s", len(found), searchName),
+			"",
+		)
+		return
+	}
+
+	t := found[0]
+	model.ID = types.StringValue(t.ID)
+	model.Description = types.StringValue(t.Description)
+	model.TemplateType = types.StringValue(t.TemplateType)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type dataSourceTemplateModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Description  types.String `tfsdk:"description"`
+	TemplateType types.String `tfsdk:"template_type"`
+}
+
+// template is a status update template of the PagerDuty account.
+type template struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	TemplateType string `json:"template_type"`
+}
+
+type listTemplatesResponse struct {
+	Templates []*template `json:"templates"`
+	More      bool        `json:"more"`
+}
+
+// requestListTemplates lists a page of the templates matching `query`. The
+// templates endpoints aren't covered by the API client.
+func requestListTemplates(ctx context.Context, client *pagerduty.Client, config *Config, query string, offset int) (*listTemplatesResponse, error) {
+	params := url.Values{}
+	params.Set("query", query)
+	params.Set("limit", strconv.Itoa(apiutil.Limit))
+	params.Set("offset", strconv.Itoa(offset))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, config.apiURL()+"/templates?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req, true)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, pagerduty.APIError{StatusCode: resp.StatusCode}
+	}
+
+	var list listTemplatesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
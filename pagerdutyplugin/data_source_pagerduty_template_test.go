@@ -0,0 +1,80 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRequestListTemplates(t *testing.T) {
+	config := &Config{}
+	client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/templates" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if got := r.URL.Query().Get("query"); got != "Outage" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch r.URL.Query().Get("offset") {
+		case "0":
+			fmt.Fprint(w, `{"templates":[{"id":"PT0001","name":"Outage update","template_type":"status_update"}],"more":true}`)
+		case "100":
+			fmt.Fprint(w, `{"templates":[{"id":"PT0002","name":"Outage","description":"Outage notice","template_type":"status_update"}],"more":false}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	})
+
+	list, err := requestListTemplates(context.Background(), client, config, "Outage", 0)
+	if err != nil {
+		t.Fatalf("error: expected the request to not fail: %v", err)
+	}
+	if !list.More || len(list.Templates) != 1 || list.Templates[0].ID != "PT0001" {
+		t.Errorf("unexpected first page: %#v", list)
+	}
+
+	list, err = requestListTemplates(context.Background(), client, config, "Outage", 100)
+	if err != nil {
+		t.Fatalf("error: expected the request to not fail: %v", err)
+	}
+	if list.More || len(list.Templates) != 1 {
+		t.Fatalf("unexpected second page: %#v", list)
+	}
+	if got := list.Templates[0]; got.ID != "PT0002" || got.Description != "Outage notice" || got.TemplateType != "status_update" {
+		t.Errorf("unexpected template: %#v", got)
+	}
+
+	if _, err := requestListTemplates(context.Background(), client, config, "Outage", 200); err == nil {
+		t.Errorf("expected the request to fail on a bad request")
+	}
+}
+
+func TestAccDataSourcePagerDutyTemplate_NotFound(t *testing.T) {
+	name := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDataSourcePagerDutyTemplateConfig(name),
+				ExpectError: regexp.MustCompile("Unable to locate any template with the name"),
+			},
+		},
+	})
+}
+
+func testAccDataSourcePagerDutyTemplateConfig(name string) string {
+	return fmt.Sprintf(`
+data "pagerduty_template" "foo" {
+	name = "%s"
+}
+`, name)
+}
@@ -2,6 +2,7 @@ package pagerduty
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -35,6 +36,41 @@ func TestAccPagerDutyServiceDependency_import(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceDependency_importMultiple(t *testing.T) {
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyBusinessServiceDependencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyBusinessServiceDependencyMultipleConfig(service, businessService, username, email, escalationPolicy),
+			},
+
+			{
+				ResourceName:      "pagerduty_service_dependency.foo",
+				ImportStateIdFunc: testAccCheckPagerDutyServiceDependencyMultipleID,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyServiceDependencyMultipleID(s *terraform.State) (string, error) {
+	businessServiceID := s.RootModule().Resources["pagerduty_business_service.foo"].Primary.ID
+	var ids []string
+	for _, id := range strings.Split(s.RootModule().Resources["pagerduty_service_dependency.foo"].Primary.ID, ",") {
+		ids = append(ids, fmt.Sprintf("%v.%v.%v", businessServiceID, "business_service", id))
+	}
+	return strings.Join(ids, ","), nil
+}
+
 func testAccCheckPagerDutyServiceDependencyID(s *terraform.State) (string, error) {
 	return fmt.Sprintf("%v.%v.%v", s.RootModule().Resources["pagerduty_business_service.foo"].Primary.ID, "business_service", s.RootModule().Resources["pagerduty_service_dependency.foo"].Primary.ID), nil
 }
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -19,6 +20,9 @@ import (
 
 type Provider struct {
 	client *pagerduty.Client
+	config *Config
+
+	serviceDependencies serviceDependencyGraph
 }
 
 func (p *Provider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -42,7 +46,12 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 			"skip_credentials_validation": schema.BoolAttribute{Optional: true},
 			"token":                       schema.StringAttribute{Optional: true},
 			"user_token":                  schema.StringAttribute{Optional: true},
+			"user_email":                  schema.StringAttribute{Optional: true},
 			"insecure_tls":                schema.BoolAttribute{Optional: true},
+			"insecure_tls_hosts":          schema.ListAttribute{Optional: true, ElementType: types.StringType},
+			"retry_timeout":               schema.StringAttribute{Optional: true},
+			"retry_timeout_long":          schema.StringAttribute{Optional: true},
+			"request_timeout":             schema.StringAttribute{Optional: true},
 		},
 		Blocks: map[string]schema.Block{
 			"use_app_oauth_scoped_token": useAppOauthScopedTokenBlock,
@@ -52,13 +61,19 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 
 func (p *Provider) DataSources(_ context.Context) [](func() datasource.DataSource) {
 	return [](func() datasource.DataSource){
+		func() datasource.DataSource { return &dataSourceAccountExport{} },
 		func() datasource.DataSource { return &dataSourceBusinessService{} },
 		func() datasource.DataSource { return &dataSourceIntegration{} },
+		func() datasource.DataSource { return &dataSourceMaintenanceWindows{} },
+		func() datasource.DataSource { return &dataSourceExtension{} },
 		func() datasource.DataSource { return &dataSourceExtensionSchema{} },
 		func() datasource.DataSource { return &dataSourceStandardsResourceScores{} },
 		func() datasource.DataSource { return &dataSourceStandardsResourcesScores{} },
 		func() datasource.DataSource { return &dataSourceStandards{} },
+		func() datasource.DataSource { return &dataSourceTemplate{} },
 		func() datasource.DataSource { return &dataSourceService{} },
+		func() datasource.DataSource { return &dataSourceServiceAnalytics{} },
+		func() datasource.DataSource { return &dataSourceSlackWorkspace{} },
 		func() datasource.DataSource { return &dataSourceTag{} },
 	}
 }
@@ -69,8 +84,12 @@ func (p *Provider) Resources(_ context.Context) [](func() resource.Resource) {
 		func() resource.Resource { return &resourceBusinessService{} },
 		func() resource.Resource { return &resourceExtensionServiceNow{} },
 		func() resource.Resource { return &resourceExtension{} },
-		func() resource.Resource { return &resourceServiceDependency{} },
+		func() resource.Resource { return &resourceIncident{} },
+		func() resource.Resource { return &resourceServiceDependency{dependencies: &p.serviceDependencies} },
+		func() resource.Resource { return &resourceStandardExclusion{} },
 		func() resource.Resource { return &resourceTagAssignment{} },
+		func() resource.Resource { return &resourceTypedServiceDependency{kind: businessServiceDependencyKind} },
+		func() resource.Resource { return &resourceTypedServiceDependency{kind: technicalServiceDependencyKind} },
 		func() resource.Resource { return &resourceTag{} },
 		func() resource.Resource { return &resourceUserHandoffNotificationRule{} },
 	}
@@ -110,12 +129,47 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		SkipCredsValidation: skipCredentialsValidation,
 		Token:               args.Token.ValueString(),
 		UserToken:           args.UserToken.ValueString(),
+		UserEmail:           args.UserEmail.ValueString(),
 		TerraformVersion:    req.TerraformVersion,
 		APIURLOverride:      args.APIURLOverride.ValueString(),
 		ServiceRegion:       serviceRegion,
 		InsecureTls:         insecureTls,
 	}
 
+	if !args.InsecureTlsHosts.IsNull() {
+		resp.Diagnostics.Append(args.InsecureTlsHosts.ElementsAs(ctx, &config.InsecureTlsHosts, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for attr, v := range map[string]types.String{
+		"retry_timeout":      args.RetryTimeout,
+		"retry_timeout_long": args.RetryTimeoutLong,
+		"request_timeout":    args.RequestTimeout,
+	} {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		d, err := time.ParseDuration(v.ValueString())
+		if err != nil || d <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr),
+				"Invalid timeout",
+				fmt.Sprintf("Value must be a positive duration such as \"2m\" or \"90s\", got %q", v.ValueString()),
+			)
+			return
+		}
+		switch attr {
+		case "retry_timeout":
+			config.RetryTime = d
+		case "retry_timeout_long":
+			config.RetryTimeLong = d
+		case "request_timeout":
+			config.RequestTimeout = d
+		}
+	}
+
 	if !args.UseAppOauthScopedToken.IsNull() {
 		blockList := []UseAppOauthScopedToken{}
 		resp.Diagnostics.Append(args.UseAppOauthScopedToken.ElementsAs(ctx, &blockList, false)...)
@@ -148,6 +202,10 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		}
 	}
 
+	if config.UserEmail == "" {
+		config.UserEmail = os.Getenv("PAGERDUTY_USER_EMAIL")
+	}
+
 	if config.AppOauthScopedToken != nil {
 		// While doing migration to terraform plugin framework, because
 		// of a limitation of the provider mux
@@ -180,8 +238,10 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		resp.Diagnostics.AddError("Cannot obtain plugin client", err.Error())
 	}
 	p.client = client
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	p.config = &config
+	data := &ProviderData{Client: client, Config: &config}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 type UseAppOauthScopedToken struct {
@@ -193,11 +253,16 @@ type UseAppOauthScopedToken struct {
 type providerArguments struct {
 	Token                     types.String `tfsdk:"token"`
 	UserToken                 types.String `tfsdk:"user_token"`
+	UserEmail                 types.String `tfsdk:"user_email"`
 	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
 	ServiceRegion             types.String `tfsdk:"service_region"`
 	APIURLOverride            types.String `tfsdk:"api_url_override"`
 	UseAppOauthScopedToken    types.List   `tfsdk:"use_app_oauth_scoped_token"`
 	InsecureTls               types.Bool   `tfsdk:"insecure_tls"`
+	InsecureTlsHosts          types.List   `tfsdk:"insecure_tls_hosts"`
+	RetryTimeout              types.String `tfsdk:"retry_timeout"`
+	RetryTimeoutLong          types.String `tfsdk:"retry_timeout_long"`
+	RequestTimeout            types.String `tfsdk:"request_timeout"`
 }
 
 type SchemaGetter interface {
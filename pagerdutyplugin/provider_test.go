@@ -2,10 +2,13 @@ package pagerduty
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
+	"github.com/PagerDuty/go-pagerduty"
 	pd "github.com/PagerDuty/terraform-provider-pagerduty/pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -98,3 +101,22 @@ func testAccPreCheckPagerDutyAbility(t *testing.T, ability string) {
 		t.Skipf("Missing ability: %s. Skipping test", ability)
 	}
 }
+
+// newTestClient sets `config` up to send its requests to a server answering
+// them with `handler`, which is closed when the test ends, and returns the
+// client built from it.
+func newTestClient(t *testing.T, config *Config, handler http.HandlerFunc) *pagerduty.Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	config.Token = "foo"
+	config.APIURLOverride = srv.URL
+	config.AppURL = srv.URL
+	config.SkipCredsValidation = true
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+	return client
+}
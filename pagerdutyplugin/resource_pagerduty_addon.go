@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -18,7 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type resourceAddon struct{ client *pagerduty.Client }
+type resourceAddon struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var (
 	_ resource.Resource                = (*resourceAddon)(nil)
@@ -60,7 +62,7 @@ func (r *resourceAddon) Create(ctx context.Context, req resource.CreateRequest,
 		)
 		return
 	}
-	model = requestGetAddon(ctx, r.client, addonResp.ID, nil, &resp.Diagnostics)
+	model = requestGetAddon(ctx, r.client, r.config, addonResp.ID, nil, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -78,7 +80,7 @@ func (r *resourceAddon) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 		return retry.RetryableError(err)
 	}
-	model := requestGetAddon(ctx, r.client, id.ValueString(), removeNotFound, &resp.Diagnostics)
+	model := requestGetAddon(ctx, r.client, r.config, id.ValueString(), removeNotFound, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -134,6 +136,7 @@ func (r *resourceAddon) Delete(ctx context.Context, req resource.DeleteRequest,
 
 func (r *resourceAddon) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceAddon) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -146,9 +149,9 @@ type resourceAddonModel struct {
 	Source types.String `tfsdk:"src"`
 }
 
-func requestGetAddon(ctx context.Context, client *pagerduty.Client, id string, handleErr func(error) *retry.RetryError, diags *diag.Diagnostics) resourceAddonModel {
+func requestGetAddon(ctx context.Context, client *pagerduty.Client, config *Config, id string, handleErr func(error) *retry.RetryError, diags *diag.Diagnostics) resourceAddonModel {
 	var addon *pagerduty.Addon
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, config.retryTimeLong(), func() *retry.RetryError {
 		var err error
 		addon, err = client.GetAddonWithContext(ctx, id)
 		if err != nil {
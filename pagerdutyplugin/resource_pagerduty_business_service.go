@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -23,6 +22,7 @@ import (
 
 type resourceBusinessService struct {
 	client *pagerduty.Client
+	config *Config
 }
 
 var (
@@ -73,7 +73,7 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 	businessServicePlan := buildPagerdutyBusinessService(&plan)
 	log.Printf("[INFO] Creating PagerDuty business service %s", plan.Name)
 
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTimeLong(), func() *retry.RetryError {
 		bs, err := r.client.CreateBusinessServiceWithContext(ctx, businessServicePlan)
 		if err != nil {
 			return retry.NonRetryableError(err)
@@ -90,7 +90,7 @@ func (r *resourceBusinessService) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
-	plan, _ = requestGetBusinessService(ctx, r.client, businessServicePlan.ID, true, &resp.Diagnostics)
+	plan, _ = requestGetBusinessService(ctx, r.client, r.config, businessServicePlan.ID, true, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -106,7 +106,7 @@ func (r *resourceBusinessService) Read(ctx context.Context, req resource.ReadReq
 	}
 	log.Printf("[INFO] Reading PagerDuty business service %s", state.ID)
 
-	state, found := requestGetBusinessService(ctx, r.client, state.ID.ValueString(), false, &resp.Diagnostics)
+	state, found := requestGetBusinessService(ctx, r.client, r.config, state.ID.ValueString(), false, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		if !found {
 			resp.State.RemoveResource(ctx)
@@ -132,7 +132,7 @@ func (r *resourceBusinessService) Update(ctx context.Context, req resource.Updat
 	}
 	log.Printf("[INFO] Updating PagerDuty business service %s", businessServicePlan.ID)
 
-	businessService, err := r.client.UpdateBusinessServiceWithContext(ctx, businessServicePlan)
+	businessService, err := requestUpdateBusinessService(ctx, r.client, r.config, businessServicePlan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error updating Business Service %s", businessServicePlan.ID),
@@ -154,8 +154,8 @@ func (r *resourceBusinessService) Delete(ctx context.Context, req resource.Delet
 	}
 	log.Printf("[INFO] Deleting PagerDuty business service %s", id.String())
 
-	err := r.client.DeleteBusinessServiceWithContext(ctx, id.ValueString())
-	if err != nil && !util.IsNotFoundError(err) {
+	err := requestDeleteBusinessService(ctx, r.client, r.config, id.ValueString())
+	if err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Error deleting Business Service %s", id),
 			err.Error(),
@@ -167,6 +167,7 @@ func (r *resourceBusinessService) Delete(ctx context.Context, req resource.Delet
 
 func (r *resourceBusinessService) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceBusinessService) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -185,10 +186,10 @@ type resourceBusinessServiceModel struct {
 	Type           types.String `tfsdk:"type"`
 }
 
-func requestGetBusinessService(ctx context.Context, client *pagerduty.Client, id string, retryNotFound bool, diags *diag.Diagnostics) (resourceBusinessServiceModel, bool) {
+func requestGetBusinessService(ctx context.Context, client *pagerduty.Client, config *Config, id string, retryNotFound bool, diags *diag.Diagnostics) (resourceBusinessServiceModel, bool) {
 	var model resourceBusinessServiceModel
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
 		businessService, err := client.GetBusinessServiceWithContext(ctx, id)
 		if err != nil {
 			if !retryNotFound && util.IsNotFoundError(err) {
@@ -214,6 +215,42 @@ func requestGetBusinessService(ctx context.Context, client *pagerduty.Client, id
 	return model, found
 }
 
+// requestUpdateBusinessService updates `businessService` and returns it as
+// reported by PagerDuty.
+func requestUpdateBusinessService(ctx context.Context, client *pagerduty.Client, config *Config, businessService *pagerduty.BusinessService) (*pagerduty.BusinessService, error) {
+	var updated *pagerduty.BusinessService
+	err := retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		bs, err := client.UpdateBusinessServiceWithContext(ctx, businessService)
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		updated = bs
+		return nil
+	})
+	return updated, err
+}
+
+// requestDeleteBusinessService deletes the business service `id`, one
+// already gone is taken as deleted.
+func requestDeleteBusinessService(ctx context.Context, client *pagerduty.Client, config *Config, id string) error {
+	return retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		err := client.DeleteBusinessServiceWithContext(ctx, id)
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				return nil
+			}
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
 func buildPagerdutyBusinessService(model *resourceBusinessServiceModel) *pagerduty.BusinessService {
 	businessService := pagerduty.BusinessService{
 		ID:             model.ID.ValueString(),
@@ -235,12 +272,18 @@ func flattenBusinessService(src *pagerduty.BusinessService) resourceBusinessServ
 		Description:    types.StringValue(src.Description),
 		HTMLUrl:        types.StringValue(src.HTMLUrl),
 		Name:           types.StringValue(src.Name),
-		Self:           types.StringValue(src.Self),
-		Summary:        types.StringValue(src.Summary),
+		Self:           types.StringNull(),
+		Summary:        types.StringNull(),
 		Type:           types.StringValue(src.Type),
 		PointOfContact: types.StringNull(),
 		Team:           types.StringNull(),
 	}
+	if src.Self != "" {
+		model.Self = types.StringValue(src.Self)
+	}
+	if src.Summary != "" {
+		model.Summary = types.StringValue(src.Summary)
+	}
 	if src.PointOfContact != "" {
 		model.PointOfContact = types.StringValue(src.PointOfContact)
 	}
@@ -3,8 +3,15 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
@@ -169,3 +176,135 @@ resource "pagerduty_business_service" "bar" {
 }
 `, teamName, businessServiceName, description, poc)
 }
+
+func TestResourcePagerDutyBusinessServiceTypeDeprecationWarning(t *testing.T) {
+	ctx := context.Background()
+	server := providerserver.NewProtocol5(New())()
+
+	providerSchema, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	objectType := providerSchema.ResourceSchemas["pagerduty_business_service"].ValueType().(tftypes.Object)
+
+	validate := func(attrs map[string]tftypes.Value) []*tfprotov5.Diagnostic {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, typ := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(typ, nil)
+		}
+		for name, v := range attrs {
+			values[name] = v
+		}
+		config, err := tfprotov5.NewDynamicValue(objectType, tftypes.NewValue(objectType, values))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := server.ValidateResourceTypeConfig(ctx, &tfprotov5.ValidateResourceTypeConfigRequest{
+			TypeName: "pagerduty_business_service",
+			Config:   &config,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Diagnostics
+	}
+
+	diags := validate(map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "foo"),
+	})
+	if len(diags) != 0 {
+		t.Errorf("expected no diagnostics without type, got %v", diags)
+	}
+
+	diags = validate(map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "foo"),
+		"type": tftypes.NewValue(tftypes.String, "business_service"),
+	})
+	if len(diags) != 1 || diags[0].Severity != tfprotov5.DiagnosticSeverityWarning || diags[0].Summary != "Attribute Deprecated" {
+		t.Errorf("expected a deprecation warning with type, got %v", diags)
+	}
+}
+
+func TestFlattenBusinessServiceWithoutSelfAndSummary(t *testing.T) {
+	model := flattenBusinessService(&pagerduty.BusinessService{ID: "PXXXXXX", Name: "foo"})
+	if !model.Self.IsNull() {
+		t.Errorf("expected self to be null, got %v", model.Self)
+	}
+	if !model.Summary.IsNull() {
+		t.Errorf("expected summary to be null, got %v", model.Summary)
+	}
+
+	model = flattenBusinessService(&pagerduty.BusinessService{
+		ID:      "PXXXXXX",
+		Name:    "foo",
+		Self:    "https://api.pagerduty.com/business_services/PXXXXXX",
+		Summary: "foo",
+	})
+	if got := model.Self.ValueString(); got != "https://api.pagerduty.com/business_services/PXXXXXX" {
+		t.Errorf("unexpected self %q", got)
+	}
+	if got := model.Summary.ValueString(); got != "foo" {
+		t.Errorf("unexpected summary %q", got)
+	}
+}
+
+// Test a rate limited update is retried until it succeeds
+func TestRequestUpdateBusinessServiceRateLimited(t *testing.T) {
+	var requests int32
+	config := &Config{RetryTime: 30 * time.Second}
+	client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(&requests, 1) <= 2 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			fmt.Fprint(w, `{"error": {"code": 2020, "message": "Rate Limit Exceeded"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"business_service": {"id": "PBS0001", "name": "foo"}}`)
+	})
+
+	bs, err := requestUpdateBusinessService(context.Background(), client, config, &pagerduty.BusinessService{ID: "PBS0001", Name: "foo"})
+	if err != nil {
+		t.Fatalf("expected the update to succeed on retry, got: %v", err)
+	}
+	if bs == nil || bs.ID != "PBS0001" {
+		t.Errorf("unexpected business service %v", bs)
+	}
+	if got := atomic.LoadInt32(&requests); got != 3 {
+		t.Errorf("expected 3 requests, got %d", got)
+	}
+}
+
+// Test deleting a business service already gone succeeds, and a bad request
+// fails without retrying
+func TestRequestDeleteBusinessService(t *testing.T) {
+	cases := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+	}{
+		{name: "not found", status: http.StatusNotFound, body: `{"error": {"code": 2100, "message": "Not Found"}}`},
+		{name: "bad request", status: http.StatusBadRequest, body: `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var requests int32
+			config := &Config{RetryTime: 30 * time.Second}
+			client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, c.body)
+			})
+
+			err := requestDeleteBusinessService(context.Background(), client, config, "PBS0001")
+			if (err != nil) != c.wantErr {
+				t.Errorf("expected error to be %v, got: %v", c.wantErr, err)
+			}
+			if got := atomic.LoadInt32(&requests); got != 1 {
+				t.Errorf("expected a single request, got %d", got)
+			}
+		})
+	}
+}
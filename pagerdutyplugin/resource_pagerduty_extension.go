@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -23,7 +22,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
 )
 
-type resourceExtension struct{ client *pagerduty.Client }
+type resourceExtension struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceExtension)(nil)
@@ -110,7 +112,7 @@ func (r *resourceExtension) Create(ctx context.Context, req resource.CreateReque
 	plan.ID = extension.ID
 
 	accessToken := buildExtensionConfigAccessToken(model.Config, &resp.Diagnostics)
-	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, &resp.Diagnostics)
+	model = requestGetExtension(ctx, r.client, r.config, plan.ID, accessToken, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -127,7 +129,7 @@ func (r *resourceExtension) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	log.Printf("[INFO] Reading PagerDuty extension %s", state.ID)
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTime(), func() *retry.RetryError {
 		extension, err := r.client.GetExtensionWithContext(ctx, state.ID.ValueString())
 		if err != nil {
 			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
@@ -183,7 +185,7 @@ func (r *resourceExtension) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	accessToken := buildExtensionConfigAccessToken(model.Config, &resp.Diagnostics)
-	model = requestGetExtension(ctx, r.client, plan.ID, accessToken, &resp.Diagnostics)
+	model = requestGetExtension(ctx, r.client, r.config, plan.ID, accessToken, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -213,10 +215,11 @@ func (r *resourceExtension) Delete(ctx context.Context, req resource.DeleteReque
 
 func (r *resourceExtension) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceExtension) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	model := requestGetExtension(ctx, r.client, req.ID, nil, &resp.Diagnostics)
+	model := requestGetExtension(ctx, r.client, r.config, req.ID, nil, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -235,9 +238,9 @@ type resourceExtensionModel struct {
 	Type             types.String         `tfsdk:"type"`
 }
 
-func requestGetExtension(ctx context.Context, client *pagerduty.Client, id string, accessToken *string, diags *diag.Diagnostics) resourceExtensionModel {
+func requestGetExtension(ctx context.Context, client *pagerduty.Client, config *Config, id string, accessToken *string, diags *diag.Diagnostics) resourceExtensionModel {
 	var model resourceExtensionModel
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
 		extension, err := client.GetExtensionWithContext(ctx, id)
 		if err != nil {
 			if util.IsBadRequestError(err) {
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -23,7 +22,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type resourceExtensionServiceNow struct{ client *pagerduty.Client }
+type resourceExtensionServiceNow struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceExtensionServiceNow)(nil)
@@ -196,6 +198,7 @@ func (r *resourceExtensionServiceNow) Delete(ctx context.Context, req resource.D
 
 func (r *resourceExtensionServiceNow) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceExtensionServiceNow) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -241,7 +244,7 @@ type requestGetExtensionServiceNowOptions struct {
 func (r *resourceExtensionServiceNow) requestGetExtensionServiceNow(ctx context.Context, opts requestGetExtensionServiceNowOptions) (resourceExtensionServiceNowModel, error) {
 	var model resourceExtensionServiceNowModel
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTime(), func() *retry.RetryError {
 		extensionServiceNow, err := r.client.GetExtensionWithContext(ctx, opts.ID)
 		if err != nil {
 			if util.IsBadRequestError(err) {
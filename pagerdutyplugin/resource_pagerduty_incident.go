@@ -0,0 +1,223 @@
+package pagerduty
+
+import (
+	"context"
+	"log"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceIncident struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var _ resource.ResourceWithConfigure = (*resourceIncident)(nil)
+
+func (r *resourceIncident) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceIncident) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_incident"
+}
+
+func (r *resourceIncident) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"title": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"service": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"urgency": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.OneOf("high", "low")},
+			},
+			"body": schema.StringAttribute{
+				Optional:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{Computed: true},
+			"html_url": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *resourceIncident) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceIncidentModel
+	if d := req.Plan.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	log.Printf("[INFO] Creating PagerDuty incident %s", model.Title)
+
+	incident, err := createIncident(ctx, r.client, r.config, buildIncidentOptions(&model))
+	if err != nil {
+		resp.Diagnostics.AddError("Error calling CreateIncidentWithContext", err.Error())
+		return
+	}
+	flattenIncident(incident, &model)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceIncident) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model resourceIncidentModel
+	if d := req.State.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	log.Printf("[INFO] Reading PagerDuty incident %s", model.ID)
+
+	var incident *pagerduty.Incident
+	err := retry.RetryContext(ctx, r.config.retryTime(), func() *retry.RetryError {
+		var err error
+		incident, err = r.client.GetIncidentWithContext(ctx, model.ID.ValueString())
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		if util.IsNotFoundError(err) {
+			log.Printf("[WARN] Removing %s because it's gone", model.ID.String())
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Error calling GetIncidentWithContext", err.Error())
+		return
+	}
+	flattenIncident(incident, &model)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceIncident) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *resourceIncident) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var id types.String
+	if d := req.State.GetAttribute(ctx, path.Root("id"), &id); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	log.Printf("[INFO] Resolving PagerDuty incident %s", id)
+
+	if err := resolveIncident(ctx, r.client, r.config, id.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Error calling ManageIncidentsWithContext", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+// createIncident triggers a new incident on behalf of the user configured in
+// the `From` header of the provider.
+func createIncident(ctx context.Context, client *pagerduty.Client, config *Config, opts *pagerduty.CreateIncidentOptions) (*pagerduty.Incident, error) {
+	from, err := config.userEmail()
+	if err != nil {
+		return nil, err
+	}
+
+	// Creation is not retried, as a request failing after reaching the API may
+	// have already opened the incident.
+	incident, err := client.CreateIncidentWithContext(ctx, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	return incident, nil
+}
+
+// resolveIncident resolves the incident with `id`. Incidents already gone are
+// considered resolved.
+func resolveIncident(ctx context.Context, client *pagerduty.Client, config *Config, id string) error {
+	from, err := config.userEmail()
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		_, err := client.ManageIncidentsWithContext(ctx, from, []pagerduty.ManageIncidentsOptions{
+			{ID: id, Status: "resolved"},
+		})
+		if err != nil {
+			if util.IsNotFoundError(err) {
+				log.Printf("[WARN] Incident %s was already gone", id)
+				return nil
+			}
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+type resourceIncidentModel struct {
+	ID      types.String `tfsdk:"id"`
+	Title   types.String `tfsdk:"title"`
+	Service types.String `tfsdk:"service"`
+	Urgency types.String `tfsdk:"urgency"`
+	Body    types.String `tfsdk:"body"`
+	Status  types.String `tfsdk:"status"`
+	HTMLURL types.String `tfsdk:"html_url"`
+}
+
+func buildIncidentOptions(model *resourceIncidentModel) *pagerduty.CreateIncidentOptions {
+	opts := &pagerduty.CreateIncidentOptions{
+		Title: model.Title.ValueString(),
+		Service: &pagerduty.APIReference{
+			ID:   model.Service.ValueString(),
+			Type: "service_reference",
+		},
+	}
+	if !model.Urgency.IsNull() && !model.Urgency.IsUnknown() {
+		opts.Urgency = model.Urgency.ValueString()
+	}
+	if !model.Body.IsNull() && model.Body.ValueString() != "" {
+		opts.Body = &pagerduty.APIDetails{
+			Type:    "incident_body",
+			Details: model.Body.ValueString(),
+		}
+	}
+	return opts
+}
+
+// flattenIncident sets the attributes of `model` known by the API. The body
+// is kept as configured, since it is not returned when reading an incident.
+func flattenIncident(incident *pagerduty.Incident, model *resourceIncidentModel) {
+	model.ID = types.StringValue(incident.ID)
+	model.Title = types.StringValue(incident.Title)
+	model.Service = types.StringValue(incident.Service.ID)
+	model.Urgency = types.StringValue(incident.Urgency)
+	model.Status = types.StringValue(incident.Status)
+	model.HTMLURL = types.StringValue(incident.HTMLURL)
+}
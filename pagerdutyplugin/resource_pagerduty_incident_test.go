@@ -0,0 +1,192 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestCreateAndResolveIncident(t *testing.T) {
+	var created, resolved map[string]interface{}
+	var createFrom, resolveFrom string
+	config := &Config{
+		UserEmail: "foo@example.com",
+		RetryTime: time.Second,
+	}
+	client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			createFrom = r.Header.Get("From")
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"incident":{"id":"PINC001","type":"incident","title":"foo","status":"triggered","urgency":"low","service":{"id":"PSRV001","type":"service_reference"},"html_url":"https://example.pagerduty.com/incidents/PINC001"}}`)
+		case http.MethodPut:
+			resolveFrom = r.Header.Get("From")
+			json.NewDecoder(r.Body).Decode(&resolved)
+			fmt.Fprint(w, `{"incidents":[{"id":"PINC001","type":"incident","status":"resolved"}]}`)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	model := resourceIncidentModel{}
+	model.Title = types.StringValue("foo")
+	model.Service = types.StringValue("PSRV001")
+	model.Urgency = types.StringValue("low")
+	model.Body = types.StringValue("bar")
+
+	incident, err := createIncident(context.Background(), client, config, buildIncidentOptions(&model))
+	if err != nil {
+		t.Fatalf("error: expected the incident to be created: %v", err)
+	}
+	if createFrom != "foo@example.com" {
+		t.Errorf("expected the From header to be %q, got %q", "foo@example.com", createFrom)
+	}
+	body := created["incident"].(map[string]interface{})
+	if body["title"] != "foo" || body["urgency"] != "low" {
+		t.Errorf("unexpected incident payload: %v", body)
+	}
+	if details := body["body"].(map[string]interface{}); details["details"] != "bar" {
+		t.Errorf("unexpected incident body: %v", details)
+	}
+
+	flattenIncident(incident, &model)
+	if model.ID.ValueString() != "PINC001" || model.Status.ValueString() != "triggered" {
+		t.Errorf("unexpected incident model: %#v", model)
+	}
+	if model.Body.ValueString() != "bar" {
+		t.Errorf("expected the body to be kept as configured, got %q", model.Body.ValueString())
+	}
+
+	if err := resolveIncident(context.Background(), client, config, model.ID.ValueString()); err != nil {
+		t.Fatalf("error: expected the incident to be resolved: %v", err)
+	}
+	if resolveFrom != "foo@example.com" {
+		t.Errorf("expected the From header to be %q, got %q", "foo@example.com", resolveFrom)
+	}
+	incidents := resolved["incidents"].([]interface{})
+	if len(incidents) != 1 {
+		t.Fatalf("expected 1 incident to be managed, got %d", len(incidents))
+	}
+	if got := incidents[0].(map[string]interface{}); got["id"] != "PINC001" || got["status"] != "resolved" {
+		t.Errorf("unexpected manage incidents payload: %v", got)
+	}
+}
+
+func TestCreateIncidentMissingUserEmail(t *testing.T) {
+	config := &Config{Token: "foo", SkipCredsValidation: true}
+	client, err := config.Client(context.Background())
+	if err != nil {
+		t.Fatalf("error: expected the client to not fail: %v", err)
+	}
+
+	model := resourceIncidentModel{}
+	model.Title = types.StringValue("foo")
+	model.Service = types.StringValue("PSRV001")
+
+	if _, err := createIncident(context.Background(), client, config, buildIncidentOptions(&model)); err == nil {
+		t.Errorf("expected creating an incident to fail without a user email")
+	}
+	if err := resolveIncident(context.Background(), client, config, "PINC001"); err == nil {
+		t.Errorf("expected resolving an incident to fail without a user email")
+	}
+}
+
+func TestAccPagerDutyIncident_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	title := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if os.Getenv("PAGERDUTY_USER_EMAIL") == "" {
+				t.Skip("PAGERDUTY_USER_EMAIL must be set to create incidents")
+			}
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyIncidentResolved,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyIncidentConfig(username, email, escalationPolicy, service, title),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("pagerduty_incident.foo", "id"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "title", title),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "urgency", "low"),
+					resource.TestCheckResourceAttr("pagerduty_incident.foo", "status", "triggered"),
+					resource.TestCheckResourceAttrPair("pagerduty_incident.foo", "service", "pagerduty_service.foo", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyIncidentResolved(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_incident" {
+			continue
+		}
+
+		ctx := context.Background()
+		incident, err := testAccProvider.client.GetIncidentWithContext(ctx, r.Primary.ID)
+		if util.IsNotFoundError(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if incident.Status != "resolved" {
+			return fmt.Errorf("Incident %s was not resolved, status is %s", r.Primary.ID, incident.Status)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyIncidentConfig(username, email, escalationPolicy, service, title string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 1
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_incident" "foo" {
+	title   = "%s"
+	service = pagerduty_service.foo.id
+	urgency = "low"
+	body    = "Created by Terraform"
+}
+`, username, email, escalationPolicy, service, title)
+}
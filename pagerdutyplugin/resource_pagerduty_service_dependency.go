@@ -6,7 +6,6 @@ import (
 	"log"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -14,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -27,11 +27,17 @@ import (
 
 type resourceServiceDependency struct {
 	client *pagerduty.Client
+	config *Config
+
+	// Dependencies validated by every pagerduty_service_dependency of the
+	// provider instance, shared to tell cycles between resources apart
+	dependencies *serviceDependencyGraph
 }
 
 var (
-	_ resource.ResourceWithConfigure   = (*resourceServiceDependency)(nil)
-	_ resource.ResourceWithImportState = (*resourceServiceDependency)(nil)
+	_ resource.ResourceWithConfigure      = (*resourceServiceDependency)(nil)
+	_ resource.ResourceWithImportState    = (*resourceServiceDependency)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceServiceDependency)(nil)
 )
 
 func (r *resourceServiceDependency) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,9 +62,7 @@ func (r *resourceServiceDependency) Schema(_ context.Context, _ resource.SchemaR
 						"service",
 					),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				PlanModifiers: []planmodifier.String{serviceDependencyTypeRequiresReplace},
 			},
 		},
 	}
@@ -72,10 +76,8 @@ func (r *resourceServiceDependency) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"type": schema.StringAttribute{
-				Required: true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{serviceDependencyTypeRequiresReplace},
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"business_service",
@@ -115,10 +117,16 @@ func (r *resourceServiceDependency) Schema(_ context.Context, _ resource.SchemaR
 		NestedObject: dependencyBlockObject,
 		Validators: []validator.List{
 			listvalidator.IsRequired(),
-			listvalidator.SizeBetween(1, 1),
+			listvalidator.SizeAtLeast(1),
 		},
 		PlanModifiers: []planmodifier.List{
-			listplanmodifier.RequiresReplace(),
+			listplanmodifier.RequiresReplaceIf(
+				func(_ context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+					resp.RequiresReplace = len(req.StateValue.Elements()) != len(req.PlanValue.Elements())
+				},
+				"Requires replacement if dependency blocks are added or removed.",
+				"Requires replacement if dependency blocks are added or removed.",
+			),
 		},
 	}
 
@@ -132,6 +140,136 @@ func (r *resourceServiceDependency) Schema(_ context.Context, _ resource.SchemaR
 	}
 }
 
+// serviceDependencyTypeRequiresReplace replaces the dependency only when the
+// type of a service actually changes, as the API takes e.g.
+// "business_service" and "business_service_reference" for the same one.
+var serviceDependencyTypeRequiresReplace = stringplanmodifier.RequiresReplaceIf(
+	func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+		resp.RequiresReplace = convertServiceDependencyType(req.StateValue.ValueString()) != convertServiceDependencyType(req.PlanValue.ValueString())
+	},
+	"Requires replacement if the type of the service changes.",
+	"Requires replacement if the type of the service changes.",
+)
+
+func (r *resourceServiceDependency) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceServiceDependencyModel
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+	resp.Diagnostics.Append(validateServiceDependencyIsNotSelf(ctx, model)...)
+	resp.Diagnostics.Append(validateServiceDependencyIsNotCyclic(ctx, model, r.dependencies)...)
+}
+
+// validateServiceDependencyIsNotSelf rejects a dependency whose supporting
+// and dependent services are the same one. Services not known until apply
+// are left to the API.
+func validateServiceDependencyIsNotSelf(ctx context.Context, model resourceServiceDependencyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.Dependency.IsNull() || model.Dependency.IsUnknown() {
+		return diags
+	}
+
+	var dependencies []*resourceServiceDependencyItemModel
+	if d := model.Dependency.ElementsAs(ctx, &dependencies, false); d.HasError() {
+		return d
+	}
+
+	for i, dep := range dependencies {
+		supportingID, ok := serviceReferenceID(dep.SupportingService)
+		if !ok {
+			continue
+		}
+		dependentID, ok := serviceReferenceID(dep.DependentService)
+		if !ok {
+			continue
+		}
+		if supportingID == dependentID {
+			diags.AddAttributeError(
+				path.Root("dependency").AtListIndex(i),
+				"Invalid service dependency",
+				fmt.Sprintf("A service can't depend on itself, but %s is both the supporting_service and the dependent_service", supportingID),
+			)
+		}
+	}
+	return diags
+}
+
+// serviceDependencyGraph holds the dependencies between services validated by
+// a provider instance, i.e. within a single plan or apply, keyed by supporting
+// and dependent service ids.
+type serviceDependencyGraph struct {
+	mu    sync.Mutex
+	edges map[[2]string]bool
+}
+
+// add records `supportingID` supporting `dependentID`, and tells whether the
+// reverse dependency is already known.
+func (g *serviceDependencyGraph) add(supportingID, dependentID string) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.edges == nil {
+		g.edges = make(map[[2]string]bool)
+	}
+	g.edges[[2]string{supportingID, dependentID}] = true
+	return g.edges[[2]string{dependentID, supportingID}]
+}
+
+// validateServiceDependencyIsNotCyclic warns about a dependency whose reverse
+// is configured in this or another pagerduty_service_dependency known to
+// `graph`, which PagerDuty only rejects once applied. Longer cycles are left
+// to the API.
+func validateServiceDependencyIsNotCyclic(ctx context.Context, model resourceServiceDependencyModel, graph *serviceDependencyGraph) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.Dependency.IsNull() || model.Dependency.IsUnknown() {
+		return diags
+	}
+
+	var dependencies []*resourceServiceDependencyItemModel
+	if d := model.Dependency.ElementsAs(ctx, &dependencies, false); d.HasError() {
+		return d
+	}
+
+	if graph == nil {
+		graph = &serviceDependencyGraph{}
+	}
+	for i, dep := range dependencies {
+		supportingID, ok := serviceReferenceID(dep.SupportingService)
+		if !ok {
+			continue
+		}
+		dependentID, ok := serviceReferenceID(dep.DependentService)
+		if !ok || supportingID == dependentID {
+			continue
+		}
+		if graph.add(supportingID, dependentID) {
+			diags.AddAttributeWarning(
+				path.Root("dependency").AtListIndex(i),
+				"Cyclic service dependency",
+				fmt.Sprintf("%[1]s supports %[2]s, but %[2]s is also configured to support %[1]s. PagerDuty will reject one of these dependencies.", supportingID, dependentID),
+			)
+		}
+	}
+	return diags
+}
+
+// serviceReferenceID returns the id of the first service of a
+// supporting_service or dependent_service block, if it is known.
+func serviceReferenceID(list types.List) (string, bool) {
+	if list.IsNull() || list.IsUnknown() || len(list.Elements()) < 1 {
+		return "", false
+	}
+	obj, ok := list.Elements()[0].(types.Object)
+	if !ok || obj.IsNull() || obj.IsUnknown() {
+		return "", false
+	}
+	id, ok := obj.Attributes()["id"].(types.String)
+	if !ok || id.IsNull() || id.IsUnknown() {
+		return "", false
+	}
+	return id.ValueString(), true
+}
+
 func (r *resourceServiceDependency) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var model resourceServiceDependencyModel
 
@@ -140,34 +278,19 @@ func (r *resourceServiceDependency) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	serviceDependency, diags := buildServiceDependencyStruct(ctx, model)
+	dependencies, diags := buildServiceDependencyStruct(ctx, model)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	dependencies := &pagerduty.ListServiceDependencies{
-		Relationships: []*pagerduty.ServiceDependency{serviceDependency},
-	}
-
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-		resourceServiceDependencyMu.Lock()
-		list, err := r.client.AssociateServiceDependenciesWithContext(ctx, dependencies)
-		resourceServiceDependencyMu.Unlock()
-		if err != nil {
-			if util.IsBadRequestError(err) {
-				return retry.NonRetryableError(err)
-			}
-			return retry.NonRetryableError(err)
-		}
-		model = flattenServiceDependency(list.Relationships, &resp.Diagnostics)
-		return nil
-	})
+	list, err := associateServiceDependency(ctx, r.client, r.config, dependencies)
 	if err != nil {
 		resp.Diagnostics.AddError("Error associating service dependency", err.Error())
 		return
 	}
 
+	model = flattenServiceDependency(list, dependencies.Relationships, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -182,16 +305,16 @@ func (r *resourceServiceDependency) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	serviceDependency, diags := buildServiceDependencyStruct(ctx, model)
+	dependencies, diags := buildServiceDependencyStruct(ctx, model)
 	if diags.HasError() {
 		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	log.Printf("Reading PagerDuty dependency %s", serviceDependency.ID)
+	log.Printf("Reading PagerDuty dependency %s", model.ID.ValueString())
 
-	serviceDependency, err := r.requestGetServiceDependency(ctx, serviceDependency.ID, serviceDependency.DependentService.ID, serviceDependency.DependentService.Type)
-	if serviceDependency == nil || util.IsNotFoundError(err) {
+	found, err := requestGetServiceDependencies(ctx, r.client, r.config, dependencies.Relationships)
+	if util.IsNotFoundError(err) || (err == nil && len(found) < len(dependencies.Relationships)) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -200,7 +323,7 @@ func (r *resourceServiceDependency) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	model = flattenServiceDependency([]*pagerduty.ServiceDependency{serviceDependency}, &resp.Diagnostics)
+	model = flattenServiceDependency(found, dependencies.Relationships, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -208,44 +331,56 @@ func (r *resourceServiceDependency) Read(ctx context.Context, req resource.ReadR
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
-func (r *resourceServiceDependency) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddWarning("Update for service dependency has no effect", "")
-}
-
-func (r *resourceServiceDependency) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var model resourceServiceDependencyModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+// Update only runs when nothing material to the API changed, e.g. a service
+// type is spelled as its '*_reference' equivalent, as any other change
+// replaces the dependency. The state is set from a fresh read.
+func (r *resourceServiceDependency) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, model resourceServiceDependencyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	model.ID = state.ID
 
-	var dependencies []*resourceServiceDependencyItemModel
-	if d := model.Dependency.ElementsAs(ctx, &dependencies, false); d.HasError() {
-		resp.Diagnostics.Append(d...)
+	dependencies, diags := buildServiceDependencyStruct(ctx, model)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
 		return
 	}
 
-	var dependents []types.Object
-	if d := dependencies[0].DependentService.ElementsAs(ctx, &dependents, false); d.HasError() {
-		resp.Diagnostics.Append(d...)
+	found, err := requestGetServiceDependencies(ctx, r.client, r.config, dependencies.Relationships)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing service dependencies", err.Error())
+		return
+	}
+	if len(found) < len(dependencies.Relationships) {
+		resp.Diagnostics.AddError("Error updating service dependency", fmt.Sprintf("Service dependency %s no longer exists", model.ID.ValueString()))
 		return
 	}
 
-	var dependent struct {
-		ID   types.String `tfsdk:"id"`
-		Type types.String `tfsdk:"type"`
+	model = flattenServiceDependency(found, dependencies.Relationships, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	if d := dependents[0].As(ctx, &dependent, basetypes.ObjectAsOptions{}); d.HasError() {
-		resp.Diagnostics.Append(d...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceServiceDependency) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model resourceServiceDependencyModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	id := model.ID.ValueString()
-	depID := dependent.ID.ValueString()
-	rt := dependent.Type.ValueString()
+	dependencies, diags := buildServiceDependencyStruct(ctx, model)
+	if diags.HasError() {
+		resp.Diagnostics.Append(diags...)
+		return
+	}
 
-	serviceDependency, err := r.requestGetServiceDependency(ctx, id, depID, rt)
-	if serviceDependency == nil || util.IsNotFoundError(err) {
+	found, err := requestGetServiceDependencies(ctx, r.client, r.config, dependencies.Relationships)
+	if util.IsNotFoundError(err) || (err == nil && len(found) == 0) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -254,55 +389,106 @@ func (r *resourceServiceDependency) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	if serviceDependency.SupportingService != nil {
-		serviceDependency.SupportingService.Type = convertServiceDependencyType(serviceDependency.SupportingService.Type)
+	for _, serviceDependency := range found {
+		if serviceDependency.SupportingService != nil {
+			serviceDependency.SupportingService.Type = convertServiceDependencyType(serviceDependency.SupportingService.Type)
+		}
+		if serviceDependency.DependentService != nil {
+			serviceDependency.DependentService.Type = convertServiceDependencyType(serviceDependency.DependentService.Type)
+		}
 	}
-	if serviceDependency.DependentService != nil {
-		serviceDependency.DependentService.Type = convertServiceDependencyType(serviceDependency.DependentService.Type)
+
+	err = disassociateServiceDependency(ctx, r.client, r.config, &pagerduty.ListServiceDependencies{Relationships: found})
+	if err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting PagerDuty service dependency %s", model.ID.ValueString()),
+			err.Error(),
+		)
+		return
 	}
 
-	err = retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-		_, err := r.client.DisassociateServiceDependenciesWithContext(ctx, &pagerduty.ListServiceDependencies{
-			Relationships: []*pagerduty.ServiceDependency{serviceDependency},
-		})
+	resp.State.RemoveResource(ctx)
+}
+
+// associateServiceDependency creates the service dependencies and returns the
+// relationships reported by PagerDuty.
+func associateServiceDependency(ctx context.Context, client *pagerduty.Client, config *Config, dependencies *pagerduty.ListServiceDependencies) ([]*pagerduty.ServiceDependency, error) {
+	var relationships []*pagerduty.ServiceDependency
+	// RetryContext returns the last error seen when it runs out of time, so
+	// whether it was a retryable one tells that the retries were exhausted.
+	exhausted := false
+	err := retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		resourceServiceDependencyMu.Lock()
+		list, err := client.AssociateServiceDependenciesWithContext(ctx, dependencies)
+		resourceServiceDependencyMu.Unlock()
 		if err != nil {
-			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+			if util.IsBadRequestError(err) {
+				exhausted = false
 				return retry.NonRetryableError(err)
 			}
+			exhausted = true
 			return retry.RetryableError(err)
 		}
+		exhausted = false
+		relationships = list.Relationships
 		return nil
 	})
-	if err != nil && !util.IsNotFoundError(err) {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Error deleting PagerDuty service dependency %s (%s) dependent of %s", id, rt, depID),
-			err.Error(),
-		)
-		return
+	if err != nil && exhausted {
+		return nil, fmt.Errorf("gave up associating the service dependency after retrying for %s: %w", config.retryTime(), err)
 	}
+	return relationships, err
+}
 
-	resp.State.RemoveResource(ctx)
+// disassociateServiceDependency removes the service dependencies, their
+// services types must be the ones used in requests.
+func disassociateServiceDependency(ctx context.Context, client *pagerduty.Client, config *Config, dependencies *pagerduty.ListServiceDependencies) error {
+	return retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		_, err := client.DisassociateServiceDependenciesWithContext(ctx, dependencies)
+		if err != nil {
+			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		return nil
+	})
+}
+
+// requestGetServiceDependencies requests each of the `dependencies` by id
+// through their dependent service, and returns the ones found.
+func requestGetServiceDependencies(ctx context.Context, client *pagerduty.Client, config *Config, dependencies []*pagerduty.ServiceDependency) ([]*pagerduty.ServiceDependency, error) {
+	var found []*pagerduty.ServiceDependency
+	for _, dep := range dependencies {
+		serviceDependency, err := requestGetServiceDependency(ctx, client, config, dep.ID, dep.DependentService.ID, dep.DependentService.Type)
+		if err != nil {
+			return nil, err
+		}
+		if serviceDependency != nil {
+			found = append(found, serviceDependency)
+		}
+	}
+	return found, nil
 }
 
 // requestGetServiceDependency requests the list of service dependencies
 // according to its resource type, then searches and returns the
 // ServiceDependency with an id equal to `id`, returns a nil ServiceDependency
 // if it is not found.
-func (r *resourceServiceDependency) requestGetServiceDependency(ctx context.Context, id, depID, rt string) (*pagerduty.ServiceDependency, error) {
+func requestGetServiceDependency(ctx context.Context, client *pagerduty.Client, config *Config, id, depID, rt string) (*pagerduty.ServiceDependency, error) {
 	var found *pagerduty.ServiceDependency
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
 		var list *pagerduty.ListServiceDependencies
 		var err error
 
 		switch rt {
 		case "service", "technical_service", "technical_service_reference":
-			list, err = r.client.ListTechnicalServiceDependenciesWithContext(ctx, depID)
+			list, err = client.ListTechnicalServiceDependenciesWithContext(ctx, depID)
 		case "business_service", "business_service_reference":
-			list, err = r.client.ListBusinessServiceDependenciesWithContext(ctx, depID)
+			list, err = client.ListBusinessServiceDependenciesWithContext(ctx, depID)
 		default:
 			err = fmt.Errorf("RT not available: %v", rt)
-			return retry.RetryableError(err)
+			return retry.NonRetryableError(err)
 		}
 		if err != nil {
 			if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
@@ -325,19 +511,31 @@ func (r *resourceServiceDependency) requestGetServiceDependency(ctx context.Cont
 
 func (r *resourceServiceDependency) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
+// ImportState takes the id of a service, its type and the id of one of its
+// dependencies, separated by dots. Dependencies managed together are
+// imported by separating several of these with commas.
 func (r *resourceServiceDependency) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.Split(req.ID, ".")
-	if len(ids) != 3 {
-		resp.Diagnostics.AddError(
-			"Error importing pagerduty_service_dependency",
-			"Expecting an importation ID formed as '<supporting_service_id>.<supporting_service_type>.<service_dependency_id>'",
-		)
+	var dependencies []*pagerduty.ServiceDependency
+	for _, importID := range strings.Split(req.ID, ",") {
+		ids := strings.Split(importID, ".")
+		if len(ids) != 3 || !isServiceDependencyType(ids[1]) {
+			resp.Diagnostics.AddError(
+				"Error importing pagerduty_service_dependency",
+				"Expecting an importation ID formed as '<supporting_service_id>.<supporting_service_type>.<service_dependency_id>', or several of them separated by commas",
+			)
+			return
+		}
+		dependencies = append(dependencies, &pagerduty.ServiceDependency{
+			ID:               ids[2],
+			DependentService: &pagerduty.ServiceObj{ID: ids[0], Type: ids[1]},
+		})
 	}
-	supID, supRt, id := ids[0], ids[1], ids[2]
-	serviceDependency, err := r.requestGetServiceDependency(ctx, id, supID, supRt)
-	if serviceDependency == nil || util.IsNotFoundError(err) {
+
+	found, err := requestGetServiceDependencies(ctx, r.client, r.config, dependencies)
+	if util.IsNotFoundError(err) || (err == nil && len(found) < len(dependencies)) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
@@ -346,7 +544,7 @@ func (r *resourceServiceDependency) ImportState(ctx context.Context, req resourc
 		return
 	}
 
-	model := flattenServiceDependency([]*pagerduty.ServiceDependency{serviceDependency}, &resp.Diagnostics)
+	model := flattenServiceDependency(found, nil, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -392,7 +590,10 @@ type resourceServiceDependencyModel struct {
 
 var resourceServiceDependencyMu sync.Mutex
 
-func buildServiceDependencyStruct(ctx context.Context, model resourceServiceDependencyModel) (*pagerduty.ServiceDependency, diag.Diagnostics) {
+// buildServiceDependencyStruct builds the relationships of all dependency
+// blocks. The ids of the relationships, comma separated in the id of the
+// resource, are set when known.
+func buildServiceDependencyStruct(ctx context.Context, model resourceServiceDependencyModel) (*pagerduty.ListServiceDependencies, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	var dependency []*resourceServiceDependencyItemModel
@@ -405,36 +606,49 @@ func buildServiceDependencyStruct(ctx context.Context, model resourceServiceDepe
 		diags.AddError("dependency length < 1", "")
 		return nil, diags
 	}
-	if len(dependency[0].SupportingService.Elements()) < 1 {
-		diags.AddError("supporting service not found for dependency", "")
-	}
-	if len(dependency[0].DependentService.Elements()) < 1 {
-		diags.AddError("dependent service not found for dependency", "")
+	for _, dep := range dependency {
+		if len(dep.SupportingService.Elements()) < 1 {
+			diags.AddError("supporting service not found for dependency", "")
+		}
+		if len(dep.DependentService.Elements()) < 1 {
+			diags.AddError("dependent service not found for dependency", "")
+		}
 	}
 	if diags.HasError() {
 		return nil, diags
 	}
 	// ^These branches should not happen because of schema Validation
 
-	ss, d := buildServiceObj(ctx, dependency[0].SupportingService.Elements()[0])
-	if d.HasError() {
-		diags.Append(d...)
-		return nil, diags
-	}
-	ds, d := buildServiceObj(ctx, dependency[0].DependentService.Elements()[0])
-	if d.HasError() {
-		diags.Append(d...)
-		return nil, diags
+	var ids []string
+	if id := model.ID.ValueString(); id != "" {
+		ids = strings.Split(id, ",")
 	}
 
-	serviceDependency := &pagerduty.ServiceDependency{
-		ID:                model.ID.ValueString(),
-		Type:              dependency[0].Type.ValueString(),
-		SupportingService: ss,
-		DependentService:  ds,
+	dependencies := &pagerduty.ListServiceDependencies{}
+	for i, dep := range dependency {
+		ss, d := buildServiceObj(ctx, dep.SupportingService.Elements()[0])
+		if d.HasError() {
+			diags.Append(d...)
+			return nil, diags
+		}
+		ds, d := buildServiceObj(ctx, dep.DependentService.Elements()[0])
+		if d.HasError() {
+			diags.Append(d...)
+			return nil, diags
+		}
+
+		serviceDependency := &pagerduty.ServiceDependency{
+			Type:              dep.Type.ValueString(),
+			SupportingService: ss,
+			DependentService:  ds,
+		}
+		if len(ids) == len(dependency) {
+			serviceDependency.ID = ids[i]
+		}
+		dependencies.Relationships = append(dependencies.Relationships, serviceDependency)
 	}
 
-	return serviceDependency, diags
+	return dependencies, diags
 }
 
 func buildServiceObj(ctx context.Context, model attr.Value) (*pagerduty.ServiceObj, diag.Diagnostics) {
@@ -453,15 +667,22 @@ func buildServiceObj(ctx context.Context, model attr.Value) (*pagerduty.ServiceO
 	return &serviceObj, diags
 }
 
-func flattenServiceReference(objType types.ObjectType, src *pagerduty.ServiceObj) (list types.List, diags diag.Diagnostics) {
+// flattenServiceReference flattens the service `src`, keeping the type as
+// spelled in `prior` when it names the same type of service.
+func flattenServiceReference(objType types.ObjectType, src, prior *pagerduty.ServiceObj) (list types.List, diags diag.Diagnostics) {
 	if src == nil {
 		diags.AddError("service reference is null", "")
 		return
 	}
 
+	serviceType := convertServiceDependencyType(src.Type)
+	if prior != nil && convertServiceDependencyType(prior.Type) == serviceType {
+		serviceType = prior.Type
+	}
+
 	serviceRef, d := types.ObjectValue(objType.AttrTypes, map[string]attr.Value{
 		"id":   types.StringValue(src.ID),
-		"type": types.StringValue(convertServiceDependencyType(src.Type)),
+		"type": types.StringValue(serviceType),
 	})
 	if diags.Append(d...); diags.HasError() {
 		return
@@ -472,45 +693,91 @@ func flattenServiceReference(objType types.ObjectType, src *pagerduty.ServiceObj
 	return
 }
 
-func flattenServiceDependency(list []*pagerduty.ServiceDependency, diags *diag.Diagnostics) (model resourceServiceDependencyModel) {
+// flattenServiceDependency flattens the relationships of `list`. Given
+// `prior`, the relationships they were built from, each one of these is
+// mapped back to the relationship of `list` between the same supporting and
+// dependent services, keeping their order and the types as spelled in
+// `prior`.
+func flattenServiceDependency(list, prior []*pagerduty.ServiceDependency, diags *diag.Diagnostics) (model resourceServiceDependencyModel) {
 	if len(list) < 1 {
 		diags.AddError("Pagerduty did not responded with any dependency", "")
 		return
 	}
-	item := list[0]
 
-	supportingService, d := flattenServiceReference(supportingServiceObjectType, item.SupportingService)
-	if diags.Append(d...); d.HasError() {
-		return
+	items := list
+	if prior != nil {
+		items = make([]*pagerduty.ServiceDependency, 0, len(prior))
+		for _, p := range prior {
+			item := findServiceDependency(list, p)
+			if item == nil {
+				diags.AddError(
+					"Pagerduty did not responded with the dependency",
+					fmt.Sprintf("No dependency of %s on %s was found", p.DependentService.ID, p.SupportingService.ID),
+				)
+				return
+			}
+			items = append(items, item)
+		}
 	}
 
-	dependentService, d := flattenServiceReference(dependentServiceObjectType, item.DependentService)
-	if diags.Append(d...); d.HasError() {
-		return
-	}
+	ids := make([]string, 0, len(items))
+	dependencies := make([]attr.Value, 0, len(items))
+	for i, item := range items {
+		var priorSupporting, priorDependent *pagerduty.ServiceObj
+		if prior != nil {
+			priorSupporting, priorDependent = prior[i].SupportingService, prior[i].DependentService
+		}
 
-	dependency, d := types.ObjectValue(
-		serviceDependencyObjectType.AttrTypes,
-		map[string]attr.Value{
-			"type":               types.StringValue(item.Type),
-			"supporting_service": supportingService,
-			"dependent_service":  dependentService,
-		},
-	)
-	if diags.Append(d...); d.HasError() {
-		return model
+		supportingService, d := flattenServiceReference(supportingServiceObjectType, item.SupportingService, priorSupporting)
+		if diags.Append(d...); d.HasError() {
+			return
+		}
+
+		dependentService, d := flattenServiceReference(dependentServiceObjectType, item.DependentService, priorDependent)
+		if diags.Append(d...); d.HasError() {
+			return
+		}
+
+		dependency, d := types.ObjectValue(
+			serviceDependencyObjectType.AttrTypes,
+			map[string]attr.Value{
+				"type":               types.StringValue(item.Type),
+				"supporting_service": supportingService,
+				"dependent_service":  dependentService,
+			},
+		)
+		if diags.Append(d...); d.HasError() {
+			return model
+		}
+
+		ids = append(ids, item.ID)
+		dependencies = append(dependencies, dependency)
 	}
 
-	dependencyList, d := types.ListValue(serviceDependencyObjectType, []attr.Value{dependency})
+	dependencyList, d := types.ListValue(serviceDependencyObjectType, dependencies)
 	if diags.Append(d...); d.HasError() {
 		return model
 	}
 
-	model.ID = types.StringValue(item.ID)
+	model.ID = types.StringValue(strings.Join(ids, ","))
 	model.Dependency = dependencyList
 	return model
 }
 
+// findServiceDependency returns the relationship of `list` between the same
+// supporting and dependent services as `dep`, or nil if there is none.
+func findServiceDependency(list []*pagerduty.ServiceDependency, dep *pagerduty.ServiceDependency) *pagerduty.ServiceDependency {
+	for _, item := range list {
+		if item.SupportingService == nil || item.DependentService == nil {
+			continue
+		}
+		if item.SupportingService.ID == dep.SupportingService.ID && item.DependentService.ID == dep.DependentService.ID {
+			return item
+		}
+	}
+	return nil
+}
+
 // convertServiceDependencyType is needed because the PagerDuty API returns
 // '*_reference' values in the response but uses the other kind of values in
 // requests
@@ -523,3 +790,13 @@ func convertServiceDependencyType(s string) string {
 	}
 	return s
 }
+
+// isServiceDependencyType tells whether `s` is one of the service types
+// accepted in an importation ID.
+func isServiceDependencyType(s string) bool {
+	switch s {
+	case "service", "technical_service", "technical_service_reference", "business_service", "business_service_reference":
+		return true
+	}
+	return false
+}
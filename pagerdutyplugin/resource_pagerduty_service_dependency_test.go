@@ -3,14 +3,139 @@ package pagerduty
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
+func TestAssociateServiceDependencyRetries(t *testing.T) {
+	cases := []struct {
+		name         string
+		statuses     []int
+		retryTime    time.Duration
+		wantErr      string
+		wantRequests int32
+	}{
+		{name: "succeeds after a transient error", statuses: []int{http.StatusNotFound, http.StatusOK}, retryTime: 30 * time.Second, wantRequests: 2},
+		{name: "bad request is not retried", statuses: []int{http.StatusBadRequest}, retryTime: 30 * time.Second, wantErr: "Invalid Input", wantRequests: 1},
+		{name: "retries are exhausted", statuses: []int{http.StatusNotFound}, retryTime: time.Second, wantErr: "gave up associating the service dependency after retrying for 1s"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var requests int32
+			config := &Config{RetryTime: c.retryTime}
+			client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&requests, 1)
+				status := c.statuses[len(c.statuses)-1]
+				if int(n) <= len(c.statuses) {
+					status = c.statuses[n-1]
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(status)
+				switch status {
+				case http.StatusOK:
+					fmt.Fprint(w, `{"relationships": [{"id": "D0000001", "supporting_service": {"id": "PSUP001", "type": "service"}, "dependent_service": {"id": "PDEP001", "type": "service"}}]}`)
+				case http.StatusBadRequest:
+					fmt.Fprint(w, `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`)
+				default:
+					fmt.Fprint(w, `{"error": {"code": 2100, "message": "Not Found"}}`)
+				}
+			})
+
+			list, err := associateServiceDependency(context.Background(), client, config, &pagerduty.ListServiceDependencies{
+				Relationships: []*pagerduty.ServiceDependency{technicalServiceDependencyKind.buildServiceDependency(resourceTypedServiceDependencyModel{
+					DependentService:  types.StringValue("PDEP001"),
+					SupportingService: types.StringValue("PSUP001"),
+				})},
+			})
+			if c.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if len(list) != 1 || list[0].ID != "D0000001" {
+					t.Errorf("unexpected relationships %v", list)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), c.wantErr) {
+				t.Errorf("expected error containing %q, got: %v", c.wantErr, err)
+			}
+			if c.wantRequests > 0 && atomic.LoadInt32(&requests) != c.wantRequests {
+				t.Errorf("expected %d requests, got %d", c.wantRequests, atomic.LoadInt32(&requests))
+			}
+		})
+	}
+}
+
+// Test reading a dependency, as done by Read and Delete, gives up at once on
+// errors that retrying won't fix
+func TestRequestGetServiceDependencyErrors(t *testing.T) {
+	cases := []struct {
+		name         string
+		rt           string
+		status       int
+		body         string
+		wantErr      bool
+		wantFound    bool
+		wantRequests int32
+	}{
+		{name: "bad request is not retried", status: http.StatusBadRequest, body: `{"error": {"code": 2001, "message": "Invalid Input Provided"}}`, wantErr: true, wantRequests: 1},
+		{name: "not found is not retried", status: http.StatusNotFound, body: `{"error": {"code": 2100, "message": "Not Found"}}`, wantErr: true, wantRequests: 1},
+		{name: "found", status: http.StatusOK, body: `{"relationships": [{"id": "D0000001", "supporting_service": {"id": "PSUP001", "type": "service"}, "dependent_service": {"id": "PDEP001", "type": "service"}}]}`, wantFound: true, wantRequests: 1},
+		{name: "missing", status: http.StatusOK, body: `{"relationships": []}`, wantRequests: 1},
+		{name: "unknown type is not retried", rt: "team", status: http.StatusOK, body: `{"relationships": []}`, wantErr: true, wantRequests: 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var requests int32
+			config := &Config{RetryTime: 30 * time.Second}
+			client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, c.body)
+			})
+
+			rt := c.rt
+			if rt == "" {
+				rt = "service"
+			}
+
+			start := time.Now()
+			found, err := requestGetServiceDependency(context.Background(), client, config, "D0000001", "PDEP001", rt)
+			elapsed := time.Since(start)
+
+			if (err != nil) != c.wantErr {
+				t.Errorf("expected error to be %v, got: %v", c.wantErr, err)
+			}
+			if (found != nil) != c.wantFound {
+				t.Errorf("expected found to be %v, got: %v", c.wantFound, found)
+			}
+			if got := atomic.LoadInt32(&requests); got != c.wantRequests {
+				t.Errorf("expected %d requests, got %d", c.wantRequests, got)
+			}
+			if elapsed > 5*time.Second {
+				t.Errorf("expected the request to not be retried, took %v", elapsed)
+			}
+		})
+	}
+}
+
 // Testing Business Service Dependencies
 func TestAccPagerDutyServiceDependency_BusinessBasic(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -36,6 +161,24 @@ func TestAccPagerDutyServiceDependency_BusinessBasic(t *testing.T) {
 						"pagerduty_service_dependency.foo", "dependency.0.dependent_service.#", "1"),
 				),
 			},
+			// Validating that spelling a service type as its '*_reference'
+			// equivalent updates the dependency in place
+			{
+				Config: strings.Replace(
+					testAccCheckPagerDutyBusinessServiceDependencyConfig(service, businessService, username, email, escalationPolicy),
+					`type = "business_service"`, `type = "business_service_reference"`, 1,
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("pagerduty_service_dependency.foo", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyBusinessServiceDependencyExists("pagerduty_service_dependency.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_dependency.foo", "dependency.0.dependent_service.0.type", "business_service_reference"),
+				),
+			},
 			// Validating that externally removed business service dependencies are
 			// detected and planned for re-creation
 			{
@@ -58,6 +201,36 @@ func TestAccPagerDutyServiceDependency_BusinessBasic(t *testing.T) {
 	})
 }
 
+func TestAccPagerDutyServiceDependency_BusinessMultiple(t *testing.T) {
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyBusinessServiceDependencyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyBusinessServiceDependencyMultipleConfig(service, businessService, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyBusinessServiceDependencyExists("pagerduty_service_dependency.foo"),
+					resource.TestCheckResourceAttr(
+						"pagerduty_service_dependency.foo", "dependency.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_dependency.foo", "dependency.0.supporting_service.0.id",
+						"pagerduty_service.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_service_dependency.foo", "dependency.1.supporting_service.0.id",
+						"pagerduty_service.bar", "id"),
+				),
+			},
+		},
+	})
+}
+
 // Testing Parallel creation of Business Service Dependencies
 func TestAccPagerDutyServiceDependency_BusinessParallel(t *testing.T) {
 	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
@@ -100,16 +273,19 @@ func testAccCheckPagerDutyBusinessServiceDependencyExists(n string) resource.Tes
 			return fmt.Errorf("Business Service not found: %v", err)
 		}
 
-		// loop serviceRelationships until relationship.IDs match
-		var found *pagerduty.ServiceDependency
-		for _, rel := range depResp.Relationships {
-			if rel.ID == rs.Primary.ID {
-				found = rel
-				break
+		// loop serviceRelationships until relationship.IDs match, for each
+		// one of the relationships managed by the resource
+		for _, id := range strings.Split(rs.Primary.ID, ",") {
+			var found *pagerduty.ServiceDependency
+			for _, rel := range depResp.Relationships {
+				if rel.ID == id {
+					found = rel
+					break
+				}
+			}
+			if found == nil {
+				return fmt.Errorf("Service Dependency not found: %v", id)
 			}
-		}
-		if found == nil {
-			return fmt.Errorf("Service Dependency not found: %v", rs.Primary.ID)
 		}
 
 		return nil
@@ -177,8 +353,10 @@ func testAccCheckPagerDutyBusinessServiceDependencyDestroy(s *terraform.State) e
 		}
 		// get business service dependencies
 		for _, rel := range dependencies.Relationships {
-			if rel.ID == r.Primary.ID {
-				return fmt.Errorf("supporting service relationship still exists")
+			for _, id := range strings.Split(r.Primary.ID, ",") {
+				if rel.ID == id {
+					return fmt.Errorf("supporting service relationship still exists")
+				}
 			}
 		}
 
@@ -292,6 +470,69 @@ resource "pagerduty_service_dependency" "foo" {
 `, businessService, username, email, escalationPolicy, service)
 }
 
+func testAccCheckPagerDutyBusinessServiceDependencyMultipleConfig(service, businessService, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+	name = "%[1]s"
+}
+
+resource "pagerduty_user" "foo" {
+	name        = "%[2]s"
+	email       = "%[3]s"
+	color       = "green"
+	role        = "user"
+	job_title   = "foo"
+	description = "foo"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name        = "%[4]s"
+	description = "bar"
+	num_loops   = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%[5]s-foo"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "bar" {
+	name              = "%[5]s-bar"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service_dependency" "foo" {
+	dependency {
+		dependent_service {
+			id = pagerduty_business_service.foo.id
+			type = "business_service"
+		}
+		supporting_service {
+			id = pagerduty_service.foo.id
+			type = "service"
+		}
+	}
+	dependency {
+		dependent_service {
+			id = pagerduty_business_service.foo.id
+			type = "business_service"
+		}
+		supporting_service {
+			id = pagerduty_service.bar.id
+			type = "service"
+		}
+	}
+}
+`, businessService, username, email, escalationPolicy, service)
+}
+
 func testAccExternallyDestroyServiceDependency(resName, depName, suppName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resName]
@@ -685,3 +926,298 @@ resource "pagerduty_service_dependency" "bar" {
 }
 `, username, email, escalationPolicy, supportingService, dependentService, resCount)
 }
+
+func TestValidateServiceDependencyIsNotSelf(t *testing.T) {
+	ctx := context.Background()
+	model := func(supportingID, dependentID string) resourceServiceDependencyModel {
+		var diags diag.Diagnostics
+		m := flattenServiceDependency([]*pagerduty.ServiceDependency{{
+			ID:                "D0000001",
+			SupportingService: &pagerduty.ServiceObj{ID: supportingID, Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: dependentID, Type: "service"},
+		}}, nil, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected errors building the model: %v", diags)
+		}
+		return m
+	}
+
+	if diags := validateServiceDependencyIsNotSelf(ctx, model("PSUP001", "PDEP001")); diags.HasError() {
+		t.Errorf("expected a dependency between different services to be valid, got: %v", diags)
+	}
+
+	diags := validateServiceDependencyIsNotSelf(ctx, model("PSRV001", "PSRV001"))
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a self-dependency")
+	}
+	errs := diags.Errors()
+	if len(errs) != 1 || !strings.Contains(errs[0].Detail(), "PSRV001") {
+		t.Errorf("expected one error naming the service, got: %v", diags)
+	}
+	if withPath, ok := errs[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("dependency").AtListIndex(0)) {
+		t.Errorf("expected the error on dependency[0], got: %v", errs[0])
+	}
+
+	unknown := model("PSRV001", "PSRV001")
+	unknown.Dependency = types.ListUnknown(serviceDependencyObjectType)
+	if diags := validateServiceDependencyIsNotSelf(ctx, unknown); diags.HasError() {
+		t.Errorf("expected a dependency known after apply to be valid, got: %v", diags)
+	}
+}
+
+// serviceDependencyTestModel builds the model of a pagerduty_service_dependency
+// with a dependency block for each pair of supporting and dependent services.
+func serviceDependencyTestModel(t *testing.T, pairs ...[2]string) resourceServiceDependencyModel {
+	var list []*pagerduty.ServiceDependency
+	for _, p := range pairs {
+		list = append(list, &pagerduty.ServiceDependency{
+			SupportingService: &pagerduty.ServiceObj{ID: p[0], Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: p[1], Type: "service"},
+		})
+	}
+	var diags diag.Diagnostics
+	m := flattenServiceDependency(list, nil, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors building the model: %v", diags)
+	}
+	return m
+}
+
+func TestValidateServiceDependencyIsNotCyclic(t *testing.T) {
+	ctx := context.Background()
+
+	// Both dependencies in the blocks of a single resource
+	graph := &serviceDependencyGraph{}
+	diags := validateServiceDependencyIsNotCyclic(ctx, serviceDependencyTestModel(t, [2]string{"PSRVA01", "PSRVB01"}, [2]string{"PSRVB01", "PSRVA01"}), graph)
+	if diags.HasError() {
+		t.Fatalf("expected a cycle to only be warned about, got: %v", diags)
+	}
+	warnings := diags.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "PSRVA01") || !strings.Contains(warnings[0].Detail(), "PSRVB01") {
+		t.Fatalf("expected one warning naming both services, got: %v", diags)
+	}
+	if withPath, ok := warnings[0].(diag.DiagnosticWithPath); !ok || !withPath.Path().Equal(path.Root("dependency").AtListIndex(1)) {
+		t.Errorf("expected the warning on dependency[1], got: %v", warnings[0])
+	}
+
+	// A dependency reversed in a later plan, validated by another provider
+	// instance, isn't a cycle
+	if diags := validateServiceDependencyIsNotCyclic(ctx, serviceDependencyTestModel(t, [2]string{"PSRVA01", "PSRVB01"}), &serviceDependencyGraph{}); len(diags) > 0 {
+		t.Errorf("expected no warning for the first dependency, got: %v", diags)
+	}
+	if diags := validateServiceDependencyIsNotCyclic(ctx, serviceDependencyTestModel(t, [2]string{"PSRVB01", "PSRVA01"}), &serviceDependencyGraph{}); len(diags) > 0 {
+		t.Errorf("expected no warning once the dependency is reversed, got: %v", diags)
+	}
+
+	// Services depending on a common one aren't a cycle
+	graph = &serviceDependencyGraph{}
+	validateServiceDependencyIsNotCyclic(ctx, serviceDependencyTestModel(t, [2]string{"PSRVA01", "PSRVC01"}), graph)
+	if diags := validateServiceDependencyIsNotCyclic(ctx, serviceDependencyTestModel(t, [2]string{"PSRVB01", "PSRVC01"}), graph); len(diags) > 0 {
+		t.Errorf("expected no warning without a cycle, got: %v", diags)
+	}
+}
+
+// Test a cycle between two pagerduty_service_dependency resources of the
+// same provider instance is warned about
+func TestResourceServiceDependencyValidateConfigCycleBetweenResources(t *testing.T) {
+	ctx := context.Background()
+	p := New()
+
+	var resources []fwresource.Resource
+	for _, newResource := range p.Resources(ctx) {
+		if r, ok := newResource().(*resourceServiceDependency); ok {
+			resources = append(resources, r, newResource())
+			break
+		}
+	}
+	if len(resources) != 2 {
+		t.Fatalf("expected the provider to have pagerduty_service_dependency")
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	resources[0].Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+
+	validate := func(r fwresource.Resource, model resourceServiceDependencyModel) diag.Diagnostics {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		if diags := state.Set(ctx, &model); diags.HasError() {
+			t.Fatalf("unexpected errors building the configuration: %v", diags)
+		}
+		req := fwresource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}}
+		var resp fwresource.ValidateConfigResponse
+		r.(fwresource.ResourceWithValidateConfig).ValidateConfig(ctx, req, &resp)
+		return resp.Diagnostics
+	}
+
+	if diags := validate(resources[0], serviceDependencyTestModel(t, [2]string{"PSRVA01", "PSRVB01"})); len(diags) > 0 {
+		t.Fatalf("expected no warning for the first resource, got: %v", diags)
+	}
+	diags := validate(resources[1], serviceDependencyTestModel(t, [2]string{"PSRVB01", "PSRVA01"}))
+	if diags.HasError() || len(diags.Warnings()) != 1 {
+		t.Errorf("expected one warning for the cycle between resources, got: %v", diags)
+	}
+}
+
+func TestServiceDependencyTypeRequiresReplace(t *testing.T) {
+	ctx := context.Background()
+	state := tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})}
+	plan := tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})}
+
+	cases := []struct {
+		old, new string
+		replace  bool
+	}{
+		{"business_service", "business_service_reference", false},
+		{"business_service_reference", "business_service", false},
+		{"service", "technical_service_reference", false},
+		{"service", "business_service", true},
+		{"business_service_reference", "technical_service_reference", true},
+	}
+	for _, c := range cases {
+		req := planmodifier.StringRequest{
+			State:      state,
+			Plan:       plan,
+			StateValue: types.StringValue(c.old),
+			PlanValue:  types.StringValue(c.new),
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		serviceDependencyTypeRequiresReplace.PlanModifyString(ctx, req, resp)
+		if resp.RequiresReplace != c.replace {
+			t.Errorf("%q -> %q: expected replace to be %v, got %v", c.old, c.new, c.replace, resp.RequiresReplace)
+		}
+	}
+}
+
+func TestFlattenServiceDependencyKeepsTypeSpelling(t *testing.T) {
+	ctx := context.Background()
+	found := []*pagerduty.ServiceDependency{{
+		ID:                "D0000001",
+		Type:              "service_dependency",
+		SupportingService: &pagerduty.ServiceObj{ID: "PSUP001", Type: "technical_service_reference"},
+		DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service_reference"},
+	}}
+	serviceTypes := func(model resourceServiceDependencyModel) (string, string) {
+		var dependencies []*resourceServiceDependencyItemModel
+		if diags := model.Dependency.ElementsAs(ctx, &dependencies, false); diags.HasError() {
+			t.Fatalf("unexpected errors reading the model: %v", diags)
+		}
+		supporting := dependencies[0].SupportingService.Elements()[0].(types.Object).Attributes()["type"].(types.String)
+		dependent := dependencies[0].DependentService.Elements()[0].(types.Object).Attributes()["type"].(types.String)
+		return supporting.ValueString(), dependent.ValueString()
+	}
+
+	var diags diag.Diagnostics
+	supporting, dependent := serviceTypes(flattenServiceDependency(found, nil, &diags))
+	if supporting != "service" || dependent != "business_service" {
+		t.Errorf("expected the types to be converted without a prior dependency, got %q and %q", supporting, dependent)
+	}
+
+	prior := []*pagerduty.ServiceDependency{{
+		SupportingService: &pagerduty.ServiceObj{ID: "PSUP001", Type: "technical_service_reference"},
+		DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service"},
+	}}
+	supporting, dependent = serviceTypes(flattenServiceDependency(found, prior, &diags))
+	if supporting != "technical_service_reference" || dependent != "business_service" {
+		t.Errorf("expected the types to be spelled as in the prior dependency, got %q and %q", supporting, dependent)
+	}
+	if diags.HasError() {
+		t.Errorf("unexpected errors: %v", diags)
+	}
+}
+
+func TestBuildServiceDependencyStructMultiple(t *testing.T) {
+	var diags diag.Diagnostics
+	model := flattenServiceDependency([]*pagerduty.ServiceDependency{
+		{
+			ID:                "D0000001",
+			SupportingService: &pagerduty.ServiceObj{ID: "PSUP001", Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service"},
+		},
+		{
+			ID:                "D0000002",
+			SupportingService: &pagerduty.ServiceObj{ID: "PSUP002", Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service"},
+		},
+	}, nil, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors building the model: %v", diags)
+	}
+	if got := model.ID.ValueString(); got != "D0000001,D0000002" {
+		t.Errorf("expected the ids of both relationships, got %q", got)
+	}
+
+	dependencies, diags := buildServiceDependencyStruct(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if len(dependencies.Relationships) != 2 {
+		t.Fatalf("expected 2 relationships, got %d", len(dependencies.Relationships))
+	}
+	for i, want := range []string{"PSUP001", "PSUP002"} {
+		rel := dependencies.Relationships[i]
+		if rel.ID != fmt.Sprintf("D000000%d", i+1) || rel.SupportingService.ID != want || rel.DependentService.ID != "PDEP001" {
+			t.Errorf("unexpected relationship %d: %+v", i, rel)
+		}
+	}
+
+	model.ID = types.StringUnknown()
+	dependencies, diags = buildServiceDependencyStruct(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	for _, rel := range dependencies.Relationships {
+		if rel.ID != "" {
+			t.Errorf("expected no id before the relationships are created, got %q", rel.ID)
+		}
+	}
+}
+
+func TestFlattenServiceDependencyMatchesRelationships(t *testing.T) {
+	ctx := context.Background()
+	prior := []*pagerduty.ServiceDependency{
+		{
+			SupportingService: &pagerduty.ServiceObj{ID: "PSUP001", Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service"},
+		},
+		{
+			SupportingService: &pagerduty.ServiceObj{ID: "PSUP002", Type: "service"},
+			DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service"},
+		},
+	}
+	// PagerDuty may report the relationships in any order
+	list := []*pagerduty.ServiceDependency{
+		{
+			ID:                "D0000002",
+			SupportingService: &pagerduty.ServiceObj{ID: "PSUP002", Type: "technical_service_reference"},
+			DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service_reference"},
+		},
+		{
+			ID:                "D0000001",
+			SupportingService: &pagerduty.ServiceObj{ID: "PSUP001", Type: "technical_service_reference"},
+			DependentService:  &pagerduty.ServiceObj{ID: "PDEP001", Type: "business_service_reference"},
+		},
+	}
+
+	var diags diag.Diagnostics
+	model := flattenServiceDependency(list, prior, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got := model.ID.ValueString(); got != "D0000001,D0000002" {
+		t.Errorf("expected the ids in the order of the dependency blocks, got %q", got)
+	}
+	var dependencies []*resourceServiceDependencyItemModel
+	if d := model.Dependency.ElementsAs(ctx, &dependencies, false); d.HasError() {
+		t.Fatalf("unexpected errors reading the model: %v", d)
+	}
+	for i, want := range []string{"PSUP001", "PSUP002"} {
+		if got, _ := serviceReferenceID(dependencies[i].SupportingService); got != want {
+			t.Errorf("expected dependency %d to be supported by %s, got %s", i, want, got)
+		}
+	}
+
+	diags = nil
+	flattenServiceDependency(list[:1], prior, &diags)
+	if !diags.HasError() {
+		t.Errorf("expected an error when a relationship is missing from the response")
+	}
+}
@@ -0,0 +1,255 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+)
+
+type resourceStandardExclusion struct {
+	client *pagerduty.Client
+	config *Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = (*resourceStandardExclusion)(nil)
+	_ resource.ResourceWithImportState = (*resourceStandardExclusion)(nil)
+)
+
+func (r *resourceStandardExclusion) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceStandardExclusion) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "pagerduty_standard_exclusion"
+}
+
+func (r *resourceStandardExclusion) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"standard": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"service": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *resourceStandardExclusion) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceStandardExclusionModel
+	if d := req.Plan.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	standardID, serviceID := model.Standard.ValueString(), model.Service.ValueString()
+	log.Printf("[INFO] Excluding service %s from PagerDuty standard %s", serviceID, standardID)
+
+	err := updateStandardExclusions(ctx, r.client, r.config, standardID, func(exclusions []pagerduty.StandardInclusionExclusion) []pagerduty.StandardInclusionExclusion {
+		if hasStandardExclusion(exclusions, serviceID) {
+			return exclusions
+		}
+		return append(exclusions, pagerduty.StandardInclusionExclusion{
+			ID:   serviceID,
+			Type: "technical_service_reference",
+		})
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error excluding service from standard", err.Error())
+		return
+	}
+
+	model = flattenStandardExclusion(standardID, serviceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceStandardExclusion) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model resourceStandardExclusionModel
+	if d := req.State.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	standardID, serviceID := model.Standard.ValueString(), model.Service.ValueString()
+	log.Printf("[INFO] Reading PagerDuty standard exclusion %s", model.ID)
+
+	standard, err := fetchStandard(ctx, r.client, r.config, standardID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading standard", err.Error())
+		return
+	}
+	if standard == nil || !hasStandardExclusion(standard.Exclusions, serviceID) {
+		log.Printf("[WARN] Removing %s because it's gone", model.ID.String())
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model = flattenStandardExclusion(standardID, serviceID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceStandardExclusion) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *resourceStandardExclusion) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model resourceStandardExclusionModel
+	if d := req.State.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	standardID, serviceID := model.Standard.ValueString(), model.Service.ValueString()
+	log.Printf("[INFO] Removing PagerDuty standard exclusion %s", model.ID)
+
+	err := updateStandardExclusions(ctx, r.client, r.config, standardID, func(exclusions []pagerduty.StandardInclusionExclusion) []pagerduty.StandardInclusionExclusion {
+		kept := make([]pagerduty.StandardInclusionExclusion, 0, len(exclusions))
+		for _, exc := range exclusions {
+			if exc.ID != serviceID {
+				kept = append(kept, exc)
+			}
+		}
+		return kept
+	})
+	if err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError("Error removing service exclusion from standard", err.Error())
+		return
+	}
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceStandardExclusion) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ".")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			"Error importing pagerduty_standard_exclusion",
+			"Expecting an importation ID formed as '<standard_id>.<service_id>'",
+		)
+		return
+	}
+
+	model := flattenStandardExclusion(ids[0], ids[1])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type resourceStandardExclusionModel struct {
+	ID       types.String `tfsdk:"id"`
+	Standard types.String `tfsdk:"standard"`
+	Service  types.String `tfsdk:"service"`
+}
+
+func flattenStandardExclusion(standardID, serviceID string) resourceStandardExclusionModel {
+	return resourceStandardExclusionModel{
+		ID:       types.StringValue(fmt.Sprintf("%s.%s", standardID, serviceID)),
+		Standard: types.StringValue(standardID),
+		Service:  types.StringValue(serviceID),
+	}
+}
+
+func hasStandardExclusion(exclusions []pagerduty.StandardInclusionExclusion, serviceID string) bool {
+	for _, exc := range exclusions {
+		if exc.ID == serviceID {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchStandard looks up the technical service standard with `id`, returning
+// nil when it doesn't exist. The API has no endpoint to get a single standard.
+func fetchStandard(ctx context.Context, client *pagerduty.Client, config *Config, id string) (*pagerduty.Standard, error) {
+	var found *pagerduty.Standard
+	err := retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		list, err := client.ListStandards(ctx, pagerduty.ListStandardsOptions{ResourceType: "technical_service"})
+		if err != nil {
+			if util.IsBadRequestError(err) {
+				return retry.NonRetryableError(err)
+			}
+			return retry.RetryableError(err)
+		}
+		found = nil
+		for i := range list.Standards {
+			if list.Standards[i].ID == id {
+				found = &list.Standards[i]
+				break
+			}
+		}
+		return nil
+	})
+	return found, err
+}
+
+// resourceStandardExclusionMu serializes the updates of standards, as every
+// exclusion is added or removed by rewriting the whole list of the standard.
+var resourceStandardExclusionMu sync.Mutex
+
+// updateStandardExclusions replaces the exclusions of the standard with `id`
+// with the result of calling `fn` with the current ones.
+func updateStandardExclusions(ctx context.Context, client *pagerduty.Client, config *Config, id string, fn func([]pagerduty.StandardInclusionExclusion) []pagerduty.StandardInclusionExclusion) error {
+	resourceStandardExclusionMu.Lock()
+	defer resourceStandardExclusionMu.Unlock()
+
+	standard, err := fetchStandard(ctx, client, config, id)
+	if err != nil {
+		return err
+	}
+	if standard == nil {
+		return pagerduty.APIError{StatusCode: http.StatusNotFound}
+	}
+
+	return requestUpdateStandardExclusions(ctx, client, config, standard, fn(standard.Exclusions))
+}
+
+// requestUpdateStandardExclusions sets the exclusions of `standard`. The
+// request is built here because the API client omits an empty list of
+// exclusions, which is needed to remove the last one.
+func requestUpdateStandardExclusions(ctx context.Context, client *pagerduty.Client, config *Config, standard *pagerduty.Standard, exclusions []pagerduty.StandardInclusionExclusion) error {
+	payload, err := json.Marshal(struct {
+		Active     bool                                   `json:"active"`
+		Exclusions []pagerduty.StandardInclusionExclusion `json:"exclusions"`
+	}{standard.Active, exclusions})
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryContext(ctx, config.retryTime(), func() *retry.RetryError {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, config.apiURL()+"/standards/"+standard.ID, bytes.NewReader(payload))
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		resp, err := client.Do(req, true)
+		if err != nil {
+			return retry.RetryableError(err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			err := pagerduty.APIError{StatusCode: resp.StatusCode}
+			if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
+}
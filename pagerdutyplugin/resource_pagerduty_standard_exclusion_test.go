@@ -0,0 +1,166 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestUpdateStandardExclusions(t *testing.T) {
+	var puts []string
+	config := &Config{RetryTime: time.Second}
+	client := newTestClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/standards":
+			fmt.Fprint(w, `{"standards":[{"id":"STD001","active":true,"resource_type":"technical_service","exclusions":[{"id":"PSRV001","type":"technical_service_reference"}]}]}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/standards/STD001":
+			b, _ := io.ReadAll(r.Body)
+			puts = append(puts, string(b))
+			fmt.Fprint(w, `{"id":"STD001"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	err := updateStandardExclusions(context.Background(), client, config, "STD001", func(exclusions []pagerduty.StandardInclusionExclusion) []pagerduty.StandardInclusionExclusion {
+		return append(exclusions, pagerduty.StandardInclusionExclusion{ID: "PSRV002", Type: "technical_service_reference"})
+	})
+	if err != nil {
+		t.Fatalf("error: expected the exclusion to be added: %v", err)
+	}
+
+	err = updateStandardExclusions(context.Background(), client, config, "STD001", func([]pagerduty.StandardInclusionExclusion) []pagerduty.StandardInclusionExclusion {
+		return []pagerduty.StandardInclusionExclusion{}
+	})
+	if err != nil {
+		t.Fatalf("error: expected the exclusions to be removed: %v", err)
+	}
+
+	expected := []string{
+		`{"active":true,"exclusions":[{"type":"technical_service_reference","id":"PSRV001"},{"type":"technical_service_reference","id":"PSRV002"}]}`,
+		`{"active":true,"exclusions":[]}`,
+	}
+	if len(puts) != len(expected) {
+		t.Fatalf("expected %d updates, got %d", len(expected), len(puts))
+	}
+	for i := range expected {
+		if puts[i] != expected[i] {
+			t.Errorf("unexpected update payload\nexpected: %s\ngot:      %s", expected[i], puts[i])
+		}
+	}
+
+	err = updateStandardExclusions(context.Background(), client, config, "STD002", func(exclusions []pagerduty.StandardInclusionExclusion) []pagerduty.StandardInclusionExclusion {
+		return exclusions
+	})
+	if err == nil {
+		t.Errorf("expected updating a missing standard to fail")
+	}
+}
+
+func TestAccPagerDutyStandardExclusion_Basic(t *testing.T) {
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyStandardExclusionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyStandardExclusionConfig(username, email, escalationPolicy, service),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyStandardExclusionExists("pagerduty_standard_exclusion.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_standard_exclusion.foo", "service", "pagerduty_service.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_standard_exclusion.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPagerDutyStandardExclusionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		ctx := context.Background()
+		standard, err := fetchStandard(ctx, testAccProvider.client, testAccProvider.config, rs.Primary.Attributes["standard"])
+		if err != nil {
+			return err
+		}
+		if standard == nil || !hasStandardExclusion(standard.Exclusions, rs.Primary.Attributes["service"]) {
+			return fmt.Errorf("Standard exclusion not found: %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyStandardExclusionDestroy(s *terraform.State) error {
+	for _, r := range s.RootModule().Resources {
+		if r.Type != "pagerduty_standard_exclusion" {
+			continue
+		}
+
+		ctx := context.Background()
+		standard, err := fetchStandard(ctx, testAccProvider.client, testAccProvider.config, r.Primary.Attributes["standard"])
+		if err != nil {
+			return err
+		}
+		if standard != nil && hasStandardExclusion(standard.Exclusions, r.Primary.Attributes["service"]) {
+			return fmt.Errorf("Standard exclusion still exists: %s", r.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccCheckPagerDutyStandardExclusionConfig(username, email, escalationPolicy, service string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 1
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+data "pagerduty_standards" "foo" {
+	resource_type = "technical_service"
+}
+
+resource "pagerduty_standard_exclusion" "foo" {
+	standard = data.pagerduty_standards.foo.standards[0].id
+	service  = pagerduty_service.foo.id
+}
+`, username, email, escalationPolicy, service)
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -20,7 +19,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
-type resourceTagAssignment struct{ client *pagerduty.Client }
+type resourceTagAssignment struct {
+	client *pagerduty.Client
+	config *Config
+}
 
 var (
 	_ resource.ResourceWithConfigure   = (*resourceTagAssignment)(nil)
@@ -73,7 +75,7 @@ func (r *resourceTagAssignment) Create(ctx context.Context, req resource.CreateR
 		},
 	}
 
-	err := retry.RetryContext(ctx, 5*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTimeLong(), func() *retry.RetryError {
 		err := r.client.AssignTagsWithContext(ctx, assign.EntityType, assign.EntityID, assignments)
 		if err != nil {
 			if util.IsBadRequestError(err) {
@@ -126,7 +128,7 @@ func (r *resourceTagAssignment) requestGetTagAssignents(ctx context.Context, mod
 	}
 
 	isFound = false
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTime(), func() *retry.RetryError {
 		opts := pagerduty.ListTagOptions{}
 		response, err := r.client.GetTagsForEntity(assign.EntityType, assign.EntityID, opts)
 		if err != nil {
@@ -158,7 +160,7 @@ func (r *resourceTagAssignment) requestGetTagAssignents(ctx context.Context, mod
 func (r *resourceTagAssignment) isFoundTagAssignment(ctx context.Context, entityType, entityID string, diags *diag.Diagnostics) bool {
 	isFound := false
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTime(), func() *retry.RetryError {
 		var err error
 
 		switch entityType {
@@ -216,7 +218,7 @@ func (r *resourceTagAssignment) Delete(ctx context.Context, req resource.DeleteR
 		},
 	}
 
-	err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
+	err := retry.RetryContext(ctx, r.config.retryTime(), func() *retry.RetryError {
 		err := r.client.AssignTagsWithContext(ctx, assign.EntityType, assign.EntityID, assignments)
 		if err != nil {
 			if util.IsBadRequestError(err) {
@@ -242,6 +244,7 @@ func (r *resourceTagAssignment) Delete(ctx context.Context, req resource.DeleteR
 
 func (r *resourceTagAssignment) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceTagAssignment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
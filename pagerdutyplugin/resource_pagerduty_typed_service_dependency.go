@@ -0,0 +1,233 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/PagerDuty/go-pagerduty"
+	"github.com/PagerDuty/terraform-provider-pagerduty/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// serviceDependencyKind fixes the types of the services of a dependency, so
+// they don't have to be spelled out in the configuration.
+type serviceDependencyKind struct {
+	typeName       string
+	dependentType  string
+	supportingType string
+}
+
+var (
+	businessServiceDependencyKind = serviceDependencyKind{
+		typeName:       "pagerduty_business_service_dependency",
+		dependentType:  "business_service",
+		supportingType: "service",
+	}
+	technicalServiceDependencyKind = serviceDependencyKind{
+		typeName:       "pagerduty_technical_service_dependency",
+		dependentType:  "service",
+		supportingType: "service",
+	}
+)
+
+// resourceTypedServiceDependency is a pagerduty_service_dependency with the
+// types of its services set by its kind.
+type resourceTypedServiceDependency struct {
+	client *pagerduty.Client
+	config *Config
+	kind   serviceDependencyKind
+}
+
+var (
+	_ resource.ResourceWithConfigure      = (*resourceTypedServiceDependency)(nil)
+	_ resource.ResourceWithImportState    = (*resourceTypedServiceDependency)(nil)
+	_ resource.ResourceWithValidateConfig = (*resourceTypedServiceDependency)(nil)
+)
+
+func (r *resourceTypedServiceDependency) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
+}
+
+func (r *resourceTypedServiceDependency) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.kind.typeName
+}
+
+func (r *resourceTypedServiceDependency) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"dependent_service": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"supporting_service": schema.StringAttribute{
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+		},
+	}
+}
+
+func (r *resourceTypedServiceDependency) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model resourceTypedServiceDependencyModel
+	if d := req.Config.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	resp.Diagnostics.Append(validateTypedServiceDependencyIsNotSelf(model)...)
+}
+
+func validateTypedServiceDependencyIsNotSelf(model resourceTypedServiceDependencyModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	s, d := model.SupportingService, model.DependentService
+	if s.IsNull() || s.IsUnknown() || d.IsNull() || d.IsUnknown() {
+		return diags
+	}
+	if s.ValueString() == d.ValueString() {
+		diags.AddAttributeError(
+			path.Root("supporting_service"),
+			"Invalid service dependency",
+			fmt.Sprintf("A service can't depend on itself, but %s is both the supporting_service and the dependent_service", s.ValueString()),
+		)
+	}
+	return diags
+}
+
+func (r *resourceTypedServiceDependency) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var model resourceTypedServiceDependencyModel
+	if d := req.Plan.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	list, err := associateServiceDependency(ctx, r.client, r.config, &pagerduty.ListServiceDependencies{
+		Relationships: []*pagerduty.ServiceDependency{r.kind.buildServiceDependency(model)},
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error associating service dependency", err.Error())
+		return
+	}
+	if len(list) < 1 {
+		resp.Diagnostics.AddError("Pagerduty did not responded with any dependency", "")
+		return
+	}
+
+	model = flattenTypedServiceDependency(list[0])
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *resourceTypedServiceDependency) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var model resourceTypedServiceDependencyModel
+	if d := req.State.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+	log.Printf("Reading PagerDuty dependency %s", model.ID)
+
+	serviceDependency, err := requestGetServiceDependency(ctx, r.client, r.config, model.ID.ValueString(), model.DependentService.ValueString(), r.kind.dependentType)
+	if util.IsNotFoundError(err) || (err == nil && serviceDependency == nil) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing service dependencies", err.Error())
+		return
+	}
+
+	model = flattenTypedServiceDependency(serviceDependency)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// Update is never called with changes, as every attribute replaces the
+// dependency, so the planned state is kept as it is.
+func (r *resourceTypedServiceDependency) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+func (r *resourceTypedServiceDependency) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var model resourceTypedServiceDependencyModel
+	if d := req.State.Get(ctx, &model); d.HasError() {
+		resp.Diagnostics.Append(d...)
+		return
+	}
+
+	err := disassociateServiceDependency(ctx, r.client, r.config, &pagerduty.ListServiceDependencies{
+		Relationships: []*pagerduty.ServiceDependency{r.kind.buildServiceDependency(model)},
+	})
+	if err != nil && !util.IsNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error deleting PagerDuty service dependency %s dependent of %s", model.ID, model.DependentService),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *resourceTypedServiceDependency) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids := strings.Split(req.ID, ".")
+	if len(ids) != 2 || ids[0] == "" || ids[1] == "" {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Error importing %s", r.kind.typeName),
+			"Expecting an importation ID formed as '<dependent_service_id>.<service_dependency_id>'",
+		)
+		return
+	}
+	depID, id := ids[0], ids[1]
+
+	serviceDependency, err := requestGetServiceDependency(ctx, r.client, r.config, id, depID, r.kind.dependentType)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing service dependencies", err.Error())
+		return
+	}
+	if serviceDependency == nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Service dependency %s not found for service %s", id, depID), "")
+		return
+	}
+
+	model := flattenTypedServiceDependency(serviceDependency)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+type resourceTypedServiceDependencyModel struct {
+	ID                types.String `tfsdk:"id"`
+	DependentService  types.String `tfsdk:"dependent_service"`
+	SupportingService types.String `tfsdk:"supporting_service"`
+}
+
+func (k serviceDependencyKind) buildServiceDependency(model resourceTypedServiceDependencyModel) *pagerduty.ServiceDependency {
+	return &pagerduty.ServiceDependency{
+		ID: model.ID.ValueString(),
+		SupportingService: &pagerduty.ServiceObj{
+			ID:   model.SupportingService.ValueString(),
+			Type: k.supportingType,
+		},
+		DependentService: &pagerduty.ServiceObj{
+			ID:   model.DependentService.ValueString(),
+			Type: k.dependentType,
+		},
+	}
+}
+
+func flattenTypedServiceDependency(src *pagerduty.ServiceDependency) resourceTypedServiceDependencyModel {
+	model := resourceTypedServiceDependencyModel{ID: types.StringValue(src.ID)}
+	if src.DependentService != nil {
+		model.DependentService = types.StringValue(src.DependentService.ID)
+	}
+	if src.SupportingService != nil {
+		model.SupportingService = types.StringValue(src.SupportingService.ID)
+	}
+	return model
+}
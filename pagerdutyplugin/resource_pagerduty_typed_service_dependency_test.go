@@ -0,0 +1,305 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestServiceDependencyKindBuildServiceDependency(t *testing.T) {
+	model := resourceTypedServiceDependencyModel{
+		ID:                types.StringValue("D0000001"),
+		DependentService:  types.StringValue("PDEP001"),
+		SupportingService: types.StringValue("PSUP001"),
+	}
+
+	cases := []struct {
+		kind           serviceDependencyKind
+		dependentType  string
+		supportingType string
+	}{
+		{businessServiceDependencyKind, "business_service", "service"},
+		{technicalServiceDependencyKind, "service", "service"},
+	}
+	for _, c := range cases {
+		dep := c.kind.buildServiceDependency(model)
+		if dep.ID != "D0000001" || dep.DependentService.ID != "PDEP001" || dep.SupportingService.ID != "PSUP001" {
+			t.Errorf("%s: unexpected ids in %+v", c.kind.typeName, dep)
+		}
+		if dep.DependentService.Type != c.dependentType {
+			t.Errorf("%s: expected dependent type %q, got %q", c.kind.typeName, c.dependentType, dep.DependentService.Type)
+		}
+		if dep.SupportingService.Type != c.supportingType {
+			t.Errorf("%s: expected supporting type %q, got %q", c.kind.typeName, c.supportingType, dep.SupportingService.Type)
+		}
+	}
+}
+
+// Test a dependency is only removed from the state when PagerDuty doesn't
+// know it, and not when reading it fails
+func TestResourceTypedServiceDependencyRead(t *testing.T) {
+	cases := []struct {
+		name        string
+		status      int
+		body        string
+		wantRemoved bool
+		wantErr     bool
+	}{
+		{name: "found", status: http.StatusOK, body: `{"relationships": [{"id": "D0000001", "supporting_service": {"id": "PSUP001", "type": "service"}, "dependent_service": {"id": "PDEP001", "type": "business_service"}}]}`},
+		{name: "missing", status: http.StatusOK, body: `{"relationships": []}`, wantRemoved: true},
+		{name: "not found", status: http.StatusNotFound, body: `{"error": {"code": 2100, "message": "Not Found"}}`, wantRemoved: true},
+		{name: "server error", status: http.StatusInternalServerError, body: `{"error": {"code": 2000, "message": "Internal Error"}}`, wantErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &resourceTypedServiceDependency{kind: businessServiceDependencyKind, config: &Config{RetryTime: time.Second}}
+			r.client = newTestClient(t, r.config, func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(c.status)
+				fmt.Fprint(w, c.body)
+			})
+
+			var schemaResp fwresource.SchemaResponse
+			r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+			model := resourceTypedServiceDependencyModel{
+				ID:                types.StringValue("D0000001"),
+				DependentService:  types.StringValue("PDEP001"),
+				SupportingService: types.StringValue("PSUP001"),
+			}
+			if diags := state.Set(ctx, &model); diags.HasError() {
+				t.Fatalf("unexpected errors building the state: %v", diags)
+			}
+
+			resp := fwresource.ReadResponse{State: state}
+			r.Read(ctx, fwresource.ReadRequest{State: state}, &resp)
+
+			if resp.Diagnostics.HasError() != c.wantErr {
+				t.Errorf("expected error to be %v, got: %v", c.wantErr, resp.Diagnostics)
+			}
+			if resp.State.Raw.IsNull() != c.wantRemoved {
+				t.Errorf("expected the dependency to be removed from the state to be %v", c.wantRemoved)
+			}
+		})
+	}
+}
+
+func TestAccPagerDutyBusinessServiceDependency_Basic(t *testing.T) {
+	service := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	businessService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTypedServiceDependencyDestroy(businessServiceDependencyKind),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyBusinessServiceDependencyWrapperConfig(service, businessService, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTypedServiceDependencyExists(businessServiceDependencyKind, "pagerduty_business_service_dependency.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_business_service_dependency.foo", "dependent_service", "pagerduty_business_service.foo", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_business_service_dependency.foo", "supporting_service", "pagerduty_service.foo", "id"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_business_service_dependency.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTypedServiceDependencyImportID("pagerduty_business_service_dependency.foo"),
+			},
+		},
+	})
+}
+
+func TestAccPagerDutyTechnicalServiceDependency_Basic(t *testing.T) {
+	dependentService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	supportingService := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	username := fmt.Sprintf("tf-%s", acctest.RandString(5))
+	email := fmt.Sprintf("%s@foo.test", username)
+	escalationPolicy := fmt.Sprintf("tf-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories(),
+		CheckDestroy:             testAccCheckPagerDutyTypedServiceDependencyDestroy(technicalServiceDependencyKind),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckPagerDutyTechnicalServiceDependencyWrapperConfig(dependentService, supportingService, username, email, escalationPolicy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPagerDutyTypedServiceDependencyExists(technicalServiceDependencyKind, "pagerduty_technical_service_dependency.foo"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_technical_service_dependency.foo", "dependent_service", "pagerduty_service.dependBar", "id"),
+					resource.TestCheckResourceAttrPair(
+						"pagerduty_technical_service_dependency.foo", "supporting_service", "pagerduty_service.supportBar", "id"),
+				),
+			},
+			{
+				ResourceName:      "pagerduty_technical_service_dependency.foo",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccTypedServiceDependencyImportID("pagerduty_technical_service_dependency.foo"),
+			},
+		},
+	})
+}
+
+func testAccTypedServiceDependencyImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+		return fmt.Sprintf("%s.%s", rs.Primary.Attributes["dependent_service"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckPagerDutyTypedServiceDependencyExists(kind serviceDependencyKind, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Service Relationship ID is set")
+		}
+
+		ctx := context.Background()
+		found, err := requestGetServiceDependency(ctx, testAccProvider.client, testAccProvider.config, rs.Primary.ID, rs.Primary.Attributes["dependent_service"], kind.dependentType)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return fmt.Errorf("Service Dependency not found: %v", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyTypedServiceDependencyDestroy(kind serviceDependencyKind) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, r := range s.RootModule().Resources {
+			if r.Type != kind.typeName {
+				continue
+			}
+
+			ctx := context.Background()
+			found, _ := requestGetServiceDependency(ctx, testAccProvider.client, testAccProvider.config, r.Primary.ID, r.Primary.Attributes["dependent_service"], kind.dependentType)
+			if found != nil {
+				return fmt.Errorf("%s still exists", r.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+func testAccCheckPagerDutyBusinessServiceDependencyWrapperConfig(service, businessService, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_business_service" "foo" {
+	name = "%s"
+}
+
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "foo" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_business_service_dependency" "foo" {
+	dependent_service  = pagerduty_business_service.foo.id
+	supporting_service = pagerduty_service.foo.id
+}
+`, businessService, username, email, escalationPolicy, service)
+}
+
+func testAccCheckPagerDutyTechnicalServiceDependencyWrapperConfig(dependentService, supportingService, username, email, escalationPolicy string) string {
+	return fmt.Sprintf(`
+resource "pagerduty_user" "foo" {
+	name  = "%s"
+	email = "%s"
+}
+
+resource "pagerduty_escalation_policy" "foo" {
+	name      = "%s"
+	num_loops = 2
+	rule {
+		escalation_delay_in_minutes = 10
+		target {
+			type = "user_reference"
+			id   = pagerduty_user.foo.id
+		}
+	}
+}
+
+resource "pagerduty_service" "supportBar" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_service" "dependBar" {
+	name              = "%s"
+	escalation_policy = pagerduty_escalation_policy.foo.id
+}
+
+resource "pagerduty_technical_service_dependency" "foo" {
+	dependent_service  = pagerduty_service.dependBar.id
+	supporting_service = pagerduty_service.supportBar.id
+}
+`, username, email, escalationPolicy, supportingService, dependentService)
+}
+
+func TestValidateTypedServiceDependencyIsNotSelf(t *testing.T) {
+	cases := []struct {
+		name       string
+		supporting types.String
+		dependent  types.String
+		wantErr    bool
+	}{
+		{"different services", types.StringValue("PSUP001"), types.StringValue("PDEP001"), false},
+		{"self-dependency", types.StringValue("PSRV001"), types.StringValue("PSRV001"), true},
+		{"known after apply", types.StringUnknown(), types.StringValue("PSRV001"), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			diags := validateTypedServiceDependencyIsNotSelf(resourceTypedServiceDependencyModel{
+				SupportingService: c.supporting,
+				DependentService:  c.dependent,
+			})
+			if diags.HasError() != c.wantErr {
+				t.Errorf("expected error %v, got: %v", c.wantErr, diags)
+			}
+		})
+	}
+}
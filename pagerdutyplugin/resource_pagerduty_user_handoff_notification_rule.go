@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/PagerDuty/go-pagerduty"
 	"github.com/PagerDuty/terraform-provider-pagerduty/util"
@@ -26,6 +25,7 @@ import (
 
 type resourceUserHandoffNotificationRule struct {
 	client *pagerduty.Client
+	config *Config
 }
 
 var (
@@ -115,7 +115,7 @@ func (r *resourceUserHandoffNotificationRule) Create(ctx context.Context, req re
 	}
 	log.Printf("[INFO] Creating PagerDuty User Handoff Notification Rule %s", plan.ID)
 
-	retryErr := helperResource.RetryContext(ctx, 2*time.Minute, func() *helperResource.RetryError {
+	retryErr := helperResource.RetryContext(ctx, r.config.retryTime(), func() *helperResource.RetryError {
 		rule, err := r.client.CreateUserOncallHandoffNotificationRuleWithContext(ctx, plan.UserID.ValueString(), *userHandoffNotificationRule)
 		if util.IsNotFoundError(err) {
 			return helperResource.RetryableError(err)
@@ -135,7 +135,7 @@ func (r *resourceUserHandoffNotificationRule) Create(ctx context.Context, req re
 		return
 	}
 
-	plan = requestGetUserHandoffNotificationRule(ctx, r.client, plan.UserID.ValueString(), userHandoffNotificationRule.ID, &resp.Diagnostics)
+	plan = requestGetUserHandoffNotificationRule(ctx, r.client, r.config, plan.UserID.ValueString(), userHandoffNotificationRule.ID, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -152,7 +152,7 @@ func (r *resourceUserHandoffNotificationRule) Read(ctx context.Context, req reso
 	log.Printf("[INFO] Reading PagerDuty User Handoff Notification Rule %s", state.ID)
 
 	var diags diag.Diagnostics
-	state = requestGetUserHandoffNotificationRule(ctx, r.client, state.UserID.ValueString(), state.ID.ValueString(), &diags)
+	state = requestGetUserHandoffNotificationRule(ctx, r.client, r.config, state.UserID.ValueString(), state.ID.ValueString(), &diags)
 	if diags.HasError() {
 		for _, d := range diags.Errors() {
 			if d.Summary() == "resource not found." {
@@ -231,6 +231,7 @@ func (r *resourceUserHandoffNotificationRule) Delete(ctx context.Context, req re
 
 func (r *resourceUserHandoffNotificationRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	resp.Diagnostics.Append(ConfigurePagerdutyClient(&r.client, req.ProviderData)...)
+	resp.Diagnostics.Append(ConfigurePagerdutyConfig(&r.config, req.ProviderData)...)
 }
 
 func (r *resourceUserHandoffNotificationRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -288,10 +289,10 @@ var resourceContactMethodObjectType = types.ObjectType{
 	},
 }
 
-func requestGetUserHandoffNotificationRule(ctx context.Context, client *pagerduty.Client, userID, ruleID string, diags *diag.Diagnostics) resourceUserHandoffNotificationRuleModel {
+func requestGetUserHandoffNotificationRule(ctx context.Context, client *pagerduty.Client, config *Config, userID, ruleID string, diags *diag.Diagnostics) resourceUserHandoffNotificationRuleModel {
 	var userHandoffNotificationRule *pagerduty.OncallHandoffNotificationRule
 
-	retryErr := helperResource.RetryContext(ctx, 2*time.Minute, func() *helperResource.RetryError {
+	retryErr := helperResource.RetryContext(ctx, config.retryTime(), func() *helperResource.RetryError {
 		var err error
 		userHandoffNotificationRule, err = client.GetUserOncallHandoffNotificationRuleWithContext(ctx, userID, ruleID)
 		if util.IsBadRequestError(err) || util.IsNotFoundError(err) {
@@ -13,6 +13,25 @@ import (
 // system should keep requesting more items, and an error if any occured.
 type AllFunc = func(offset int) (bool, error)
 
+// AllPagesFunc is a signature to use with function `AllPages`, it receives the
+// current number of items already listed, it returns the number of items in
+// the page requested, a boolean signaling whether the system should keep
+// requesting more items, and an error if any occured.
+type AllPagesFunc = func(offset int) (int, bool, error)
+
+// AllOptions customizes how `AllPages` retries the request of each page.
+type AllOptions struct {
+	// Maximum time to keep retrying a page, two minutes when not set
+	Timeout time.Duration
+
+	// Time to wait before retrying a failed page
+	Delay time.Duration
+
+	// Reports the errors not worth retrying, bad requests from PagerDuty's
+	// API client when not set
+	IsNonRetryable func(err error) bool
+}
+
 // Limit is the maximum amount of items a single request to PagerDuty's API
 // should response
 const Limit = 100
@@ -20,22 +39,47 @@ const Limit = 100
 // All provides a boilerplate to request all pages from a list of a resource
 // from PagerDuty's API
 func All(ctx context.Context, requestFn AllFunc) error {
+	return AllPages(ctx, AllOptions{}, func(offset int) (int, bool, error) {
+		more, err := requestFn(offset)
+		return Limit, more, err
+	})
+}
+
+// AllPages provides a boilerplate to request all pages from a list of a
+// resource from PagerDuty's API, moving the offset by the number of items
+// listed on each page. It stops on an empty page, so a misreported `more`
+// can't loop forever.
+func AllPages(ctx context.Context, opts AllOptions, requestFn AllPagesFunc) error {
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 2 * time.Minute
+	}
+	isNonRetryable := opts.IsNonRetryable
+	if isNonRetryable == nil {
+		isNonRetryable = util.IsBadRequestError
+	}
+
 	offset := 0
 	keepSearching := true
 
 	for keepSearching {
-		err := retry.RetryContext(ctx, 2*time.Minute, func() *retry.RetryError {
-			more, err := requestFn(offset)
+		err := retry.RetryContext(ctx, timeout, func() *retry.RetryError {
+			n, more, err := requestFn(offset)
 
 			if err != nil {
-				if util.IsBadRequestError(err) {
+				if isNonRetryable(err) {
 					return retry.NonRetryableError(err)
 				}
+
+				select {
+				case <-ctx.Done():
+				case <-time.After(opts.Delay):
+				}
 				return retry.RetryableError(err)
 			}
 
-			offset += Limit
-			keepSearching = more
+			offset += n
+			keepSearching = more && n > 0
 			return nil
 		})
 
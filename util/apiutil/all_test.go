@@ -0,0 +1,72 @@
+package apiutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAllPages(t *testing.T) {
+	pages := map[int][]string{0: {"a", "b"}, 2: {"c"}}
+	var offsets []int
+	err := AllPages(context.Background(), AllOptions{Timeout: time.Second}, func(offset int) (int, bool, error) {
+		offsets = append(offsets, offset)
+		page := pages[offset]
+		return len(page), offset == 0, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if fmt.Sprint(offsets) != "[0 2]" {
+		t.Errorf("expected pages at offsets [0 2], got %v", offsets)
+	}
+
+	// An empty page stops the listing even when more are reported
+	calls := 0
+	err = AllPages(context.Background(), AllOptions{Timeout: time.Second}, func(offset int) (int, bool, error) {
+		calls++
+		return 0, true, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single request, got %d", calls)
+	}
+
+	// Failed pages are retried
+	calls = 0
+	err = AllPages(context.Background(), AllOptions{Timeout: 5 * time.Second}, func(offset int) (int, bool, error) {
+		calls++
+		if calls == 1 {
+			return 0, false, errors.New("temporary")
+		}
+		return 1, false, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected the failed page to be retried once, got %d requests", calls)
+	}
+
+	// Non retryable errors fail right away
+	calls = 0
+	permanent := errors.New("permanent")
+	opts := AllOptions{
+		Timeout:        5 * time.Second,
+		IsNonRetryable: func(err error) bool { return errors.Is(err, permanent) },
+	}
+	err = AllPages(context.Background(), opts, func(offset int) (int, bool, error) {
+		calls++
+		return 0, false, permanent
+	})
+	if !errors.Is(err, permanent) {
+		t.Errorf("expected the non retryable error, got: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected a single request, got %d", calls)
+	}
+}
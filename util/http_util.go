@@ -1,6 +1,8 @@
 package util
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"net/http"
 	"regexp"
@@ -35,3 +37,53 @@ func IsNotFoundError(err error) bool {
 	// this regexp.
 	return notFoundErrorRegexp.MatchString(err.Error())
 }
+
+// InsecureHostsTLSConfig returns a TLS configuration which skips the
+// verification of certificates only for connections to the given hostnames,
+// e.g. a corporate proxy, keeping the default verification for any other host.
+func InsecureHostsTLSConfig(hosts []string) *tls.Config {
+	insecure := make(map[string]bool, len(hosts))
+	for _, h := range hosts {
+		insecure[h] = true
+	}
+
+	return &tls.Config{
+		// Verification is done by VerifyConnection instead, because the
+		// default one can't be turned off on a per host basis.
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			if insecure[cs.ServerName] {
+				return nil
+			}
+			if len(cs.PeerCertificates) == 0 {
+				return errors.New("tls: server did not provide any certificate")
+			}
+
+			opts := x509.VerifyOptions{
+				DNSName:       cs.ServerName,
+				Intermediates: x509.NewCertPool(),
+			}
+			for _, cert := range cs.PeerCertificates[1:] {
+				opts.Intermediates.AddCert(cert)
+			}
+			_, err := cs.PeerCertificates[0].Verify(opts)
+			return err
+		},
+	}
+}
+
+// FromHeaderTransport sets the `From` header, which some endpoints of the
+// PagerDuty API require with the email of a user of the account, on every
+// request not setting it already.
+type FromHeaderTransport struct {
+	Email string
+	Next  http.RoundTripper
+}
+
+func (t *FromHeaderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.Email != "" && req.Header.Get("From") == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("From", t.Email)
+	}
+	return t.Next.RoundTrip(req)
+}
@@ -0,0 +1,85 @@
+package util
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestInsecureHostsTLSConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// Route requests for a made up hostname to the test server, since
+	// certificate verification is keyed by the hostname requested
+	host := "proxy.pagerduty.test"
+	u, err := url.Parse(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.Host = host + ":" + u.Port()
+
+	cases := []struct {
+		name    string
+		hosts   []string
+		wantErr bool
+	}{
+		{name: "no hosts listed", hosts: nil, wantErr: true},
+		{name: "other host listed", hosts: []string{"api.pagerduty.com"}, wantErr: true},
+		{name: "server host listed", hosts: []string{host}, wantErr: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			transport := http.DefaultTransport.(*http.Transport).Clone()
+			transport.TLSClientConfig = InsecureHostsTLSConfig(c.hosts)
+			transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, ts.Listener.Addr().String())
+			}
+			client := &http.Client{Transport: transport}
+
+			resp, err := client.Get(u.String())
+			if err == nil {
+				resp.Body.Close()
+			}
+			if c.wantErr && err == nil {
+				t.Errorf("expected certificate verification to fail")
+			}
+			if !c.wantErr && err != nil {
+				t.Errorf("expected certificate verification to be skipped: %v", err)
+			}
+		})
+	}
+}
+
+func TestFromHeaderTransport(t *testing.T) {
+	var from string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from = r.Header.Get("From")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client := &http.Client{Transport: &FromHeaderTransport{Email: "foo@example.com", Next: http.DefaultTransport}}
+
+	if _, err := client.Get(ts.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if from != "foo@example.com" {
+		t.Errorf("expected the From header to be set, got %q", from)
+	}
+
+	req, _ := http.NewRequest(http.MethodGet, ts.URL, nil)
+	req.Header.Set("From", "bar@example.com")
+	if _, err := client.Do(req); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if from != "bar@example.com" {
+		t.Errorf("expected the From header of the request to be kept, got %q", from)
+	}
+}
@@ -0,0 +1,29 @@
+package validate
+
+import "fmt"
+
+// Colors is the palette PagerDuty accepts for the schedule color of a user.
+var Colors = []string{
+	"purple", "red", "green", "blue", "teal", "orange", "brown", "turquoise",
+	"dark-slate-blue", "cayenne", "orange-red", "dark-orchid",
+	"dark-slate-grey", "lime", "dark-magenta", "lime-green", "midnight-blue",
+	"deep-pink", "dark-green", "dark-orange", "dark-cyan", "darkolive-green",
+	"dark-slate-gray", "grey20", "firebrick", "maroon", "crimson", "dark-red",
+	"dark-goldenrod", "chocolate", "medium-violet-red", "sea-green",
+	"olivedrab", "forest-green", "dark-olive-green", "blue-violet",
+	"royal-blue", "indigo", "slate-blue", "saddle-brown", "steel-blue",
+}
+
+// Color checks the color is part of PagerDuty's palette.
+func Color(color string) error {
+	for _, c := range Colors {
+		if c == color {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %q", Colors)
+}
+
+// ColorDiagFunc is a schema.SchemaValidateDiagFunc which validates a color
+// with Color.
+var ColorDiagFunc = DiagFunc("color", Color)
@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestColor(t *testing.T) {
+	for _, color := range []string{"green", "dark-slate-blue", "grey20", "steel-blue"} {
+		if err := Color(color); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", color, err)
+		}
+	}
+
+	for _, color := range []string{"", "Green", "#00ff00", "gray20", "light-blue"} {
+		if err := Color(color); err == nil {
+			t.Errorf("expected %q to be invalid", color)
+		}
+	}
+}
+
+func TestColorDiagFunc(t *testing.T) {
+	path := cty.Path{cty.GetAttrStep{Name: "color"}}
+
+	if diags := ColorDiagFunc("green", path); diags.HasError() {
+		t.Errorf("expected no errors, got: %v", diags)
+	}
+
+	diags := ColorDiagFunc("chartreuse", path)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a color outside the palette")
+	}
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("expected the error to point to %v, got %v", path, diags[0].AttributePath)
+	}
+}
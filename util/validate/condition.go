@@ -0,0 +1,101 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ConditionExpression performs a basic syntactic validation of a PagerDuty
+// Condition Language (PCL) expression, such as the ones used by Event
+// Orchestration rules. It only catches obvious mistakes, i.e. unbalanced
+// parentheses, unterminated strings and misplaced logical operators, leaving
+// any other validation to the API.
+func ConditionExpression(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return fmt.Errorf("condition expression cannot be empty")
+	}
+
+	var words []string
+	var word strings.Builder
+	var quote rune
+	escaped := false
+	depth := 0
+
+	flushWord := func() {
+		if word.Len() > 0 {
+			words = append(words, word.String())
+			word.Reset()
+		}
+	}
+
+	for i, r := range expr {
+		if quote != 0 {
+			switch {
+			case escaped:
+				escaped = false
+			case r == '\\':
+				escaped = true
+			case r == quote:
+				quote = 0
+				words = append(words, "''")
+			}
+			continue
+		}
+
+		switch {
+		case r == '\'' || r == '"':
+			flushWord()
+			quote = r
+		case r == '(':
+			flushWord()
+			depth++
+		case r == ')':
+			flushWord()
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected closing parenthesis at position %d", i)
+			}
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			flushWord()
+		default:
+			word.WriteRune(r)
+		}
+	}
+	flushWord()
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated string literal, missing closing %c", quote)
+	}
+	if depth > 0 {
+		return fmt.Errorf("%d unclosed parenthesis", depth)
+	}
+
+	for i, w := range words {
+		if w == "&&" || w == "||" {
+			return fmt.Errorf("unknown operator %q, use \"and\" or \"or\" instead", w)
+		}
+		if !isLogicalOperator(w) {
+			continue
+		}
+		if i == 0 || i == len(words)-1 {
+			return fmt.Errorf("logical operator %q is missing an operand", w)
+		}
+		if isLogicalOperator(words[i+1]) {
+			return fmt.Errorf("logical operator %q is followed by %q", w, words[i+1])
+		}
+	}
+
+	return nil
+}
+
+// ConditionExpressionDiagFunc is a schema.SchemaValidateDiagFunc which
+// validates a condition expression with ConditionExpression.
+var ConditionExpressionDiagFunc = DiagFunc("condition expression", ConditionExpression)
+
+func isLogicalOperator(w string) bool {
+	switch strings.ToLower(w) {
+	case "and", "or":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestConditionExpression(t *testing.T) {
+	valid := []string{
+		"event.summary matches 'foo'",
+		"event.summary matches part 'database' and event.source exists",
+		"(event.severity matches 'critical' or event.severity matches 'error') and not event.summary matches part 'test'",
+		`event.custom_details.message matches regex '^(foo|bar)\'s \(baz'`,
+		`event.summary matches "and or ( '"`,
+		"now in Mon,Tue,Wed 09:00:00 to 17:00:00 America/Los_Angeles",
+		"event.custom_details.operands contains 'or'",
+	}
+	for _, expr := range valid {
+		if err := ConditionExpression(expr); err != nil {
+			t.Errorf("expected %q to be valid, got: %s", expr, err)
+		}
+	}
+
+	malformed := []string{
+		"",
+		"   ",
+		"(event.summary matches 'foo'",
+		"event.summary matches 'foo')",
+		"event.summary matches 'foo",
+		"event.summary matches 'foo' and",
+		"or event.summary matches 'foo'",
+		"event.summary matches 'foo' and or event.source exists",
+		"event.summary matches 'foo' && event.source exists",
+		"event.summary matches 'foo' || event.source exists",
+		"((event.summary matches 'foo') and (event.source exists)",
+	}
+	for _, expr := range malformed {
+		if err := ConditionExpression(expr); err == nil {
+			t.Errorf("expected %q to be malformed", expr)
+		}
+	}
+}
+
+func TestConditionExpressionDiagFunc(t *testing.T) {
+	path := cty.Path{cty.GetAttrStep{Name: "expression"}}
+
+	if diags := ConditionExpressionDiagFunc("event.summary matches 'foo'", path); diags.HasError() {
+		t.Errorf("expected no errors, got: %v", diags)
+	}
+
+	diags := ConditionExpressionDiagFunc("(event.summary matches 'foo'", path)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for a malformed expression")
+	}
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("expected the error to point to %v, got %v", path, diags[0].AttributePath)
+	}
+}
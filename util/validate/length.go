@@ -0,0 +1,25 @@
+package validate
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// MaxLength checks the value has at most `max` characters, counted as the API
+// does, in Unicode code points rather than bytes.
+func MaxLength(s string, max int) error {
+	if n := utf8.RuneCountInString(s); n > max {
+		return fmt.Errorf("must be at most %d characters long, got %d", max, n)
+	}
+	return nil
+}
+
+// MaxLengthDiagFunc returns a schema.SchemaValidateDiagFunc which validates
+// the length of a string with MaxLength.
+func MaxLengthDiagFunc(max int) schema.SchemaValidateDiagFunc {
+	return DiagFunc("value", func(s string) error {
+		return MaxLength(s, max)
+	})
+}
@@ -0,0 +1,39 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestMaxLength(t *testing.T) {
+	for _, s := range []string{"", "SRE", strings.Repeat("a", 100), strings.Repeat("ñ", 100)} {
+		if err := MaxLength(s, 100); err != nil {
+			t.Errorf("expected %d characters to be valid, got: %v", len([]rune(s)), err)
+		}
+	}
+
+	for _, s := range []string{strings.Repeat("a", 101), strings.Repeat("ñ", 101)} {
+		if err := MaxLength(s, 100); err == nil {
+			t.Errorf("expected %d characters to be invalid", len([]rune(s)))
+		}
+	}
+}
+
+func TestMaxLengthDiagFunc(t *testing.T) {
+	path := cty.Path{cty.GetAttrStep{Name: "job_title"}}
+	validateFn := MaxLengthDiagFunc(100)
+
+	if diags := validateFn("Site Reliability Engineer", path); diags.HasError() {
+		t.Errorf("expected no errors, got: %v", diags)
+	}
+
+	diags := validateFn(strings.Repeat("a", 101), path)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for an over-limit value")
+	}
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("expected the error to point to %v, got %v", path, diags[0].AttributePath)
+	}
+}
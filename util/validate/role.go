@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Roles are the base roles PagerDuty accepts for a user.
+var Roles = []string{
+	"admin",
+	"limited_user",
+	"observer",
+	"owner",
+	"read_only_limited_user",
+	"read_only_user",
+	"restricted_access",
+	"user",
+}
+
+// roleRequirements explains the roles that can only be assigned on some
+// accounts, so a plan can warn about them before the API rejects the request.
+var roleRequirements = map[string]string{
+	"observer":               "is only available on accounts with Advanced Permissions",
+	"owner":                  "belongs to the account owner and can't be assigned to other users",
+	"read_only_limited_user": "requires a Stakeholder license",
+	"read_only_user":         "requires a Stakeholder license",
+	"restricted_access":      "is only available on accounts with Advanced Permissions",
+}
+
+// Role checks the role is one of PagerDuty's user roles.
+func Role(role string) error {
+	for _, r := range Roles {
+		if r == role {
+			return nil
+		}
+	}
+	return fmt.Errorf("must be one of %q", Roles)
+}
+
+// RoleRequirement returns what the account needs for `role` to be assigned,
+// or an empty string when any account can assign it.
+func RoleRequirement(role string) string {
+	return roleRequirements[role]
+}
+
+// RoleDiagFunc is a schema.SchemaValidateDiagFunc which validates a role with
+// Role, and warns when the role depends on the account.
+func RoleDiagFunc(v interface{}, p cty.Path) diag.Diagnostics {
+	diags := roleDiagFunc(v, p)
+	if diags.HasError() {
+		return diags
+	}
+
+	role, _ := v.(string)
+	if req := RoleRequirement(role); req != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       fmt.Sprintf("The role %q %s", role, req),
+			AttributePath: p,
+		})
+	}
+
+	return diags
+}
+
+var roleDiagFunc = DiagFunc("role", Role)
@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestRole(t *testing.T) {
+	for _, role := range Roles {
+		if err := Role(role); err != nil {
+			t.Errorf("expected %q to be valid, got: %v", role, err)
+		}
+	}
+
+	for _, role := range []string{"", "Admin", "manager", "responder", "stakeholder", "read_only"} {
+		if err := Role(role); err == nil {
+			t.Errorf("expected %q to be invalid", role)
+		}
+	}
+}
+
+func TestRoleDiagFunc(t *testing.T) {
+	path := cty.Path{cty.GetAttrStep{Name: "role"}}
+
+	for _, role := range []string{"admin", "limited_user", "user"} {
+		if diags := RoleDiagFunc(role, path); len(diags) > 0 {
+			t.Errorf("expected no diagnostics for %q, got: %v", role, diags)
+		}
+	}
+
+	for _, role := range []string{"observer", "owner", "read_only_limited_user", "read_only_user", "restricted_access"} {
+		diags := RoleDiagFunc(role, path)
+		if len(diags) != 1 || diags[0].Severity != diag.Warning {
+			t.Errorf("expected one warning for %q, got: %v", role, diags)
+		}
+	}
+
+	diags := RoleDiagFunc("manager", path)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for an unknown role")
+	}
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("expected the error to point to %v, got %v", path, diags[0].AttributePath)
+	}
+}
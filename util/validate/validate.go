@@ -0,0 +1,34 @@
+// Package validate holds plan time validations shared by the resources of
+// both the SDK and the framework providers.
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// DiagFunc returns a schema.SchemaValidateDiagFunc which validates a string
+// with `check`, reporting its error as the value not being a valid `name`.
+func DiagFunc(name string, check func(string) error) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, p cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		s, ok := v.(string)
+		if !ok {
+			return diags
+		}
+
+		if err := check(s); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       fmt.Sprintf("%q is not a valid %s: %s", s, name, err),
+				AttributePath: p,
+			})
+		}
+
+		return diags
+	}
+}
@@ -0,0 +1,36 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestDiagFunc(t *testing.T) {
+	path := cty.Path{cty.GetAttrStep{Name: "name"}}
+	validateFn := DiagFunc("name", func(s string) error {
+		if s == "" {
+			return errors.New("must not be empty")
+		}
+		return nil
+	})
+
+	if diags := validateFn("foo", path); diags.HasError() {
+		t.Errorf("expected no errors, got: %v", diags)
+	}
+	if diags := validateFn(42, path); diags.HasError() {
+		t.Errorf("expected values other than strings to be ignored, got: %v", diags)
+	}
+
+	diags := validateFn("", path)
+	if !diags.HasError() {
+		t.Fatalf("expected an error for an empty value")
+	}
+	if want := `"" is not a valid name: must not be empty`; diags[0].Summary != want {
+		t.Errorf("expected the summary to be %q, got %q", want, diags[0].Summary)
+	}
+	if !diags[0].AttributePath.Equals(path) {
+		t.Errorf("expected the error to point to %v, got %v", path, diags[0].AttributePath)
+	}
+}